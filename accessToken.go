@@ -34,7 +34,12 @@ type ListAccessTokensRoot struct {
 }
 
 func (s *accessTokenServiceHandler) List(ctx context.Context, options *ListOptions) ([]AccessToken, error) {
-	path := fmt.Sprintf("%s/access/token", accessTokenBasePath)
+	ctx = withOperation(ctx, "AccessTokenService", "List")
+
+	path, err := addOptions(fmt.Sprintf("%s/access/token", accessTokenBasePath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -50,6 +55,8 @@ func (s *accessTokenServiceHandler) List(ctx context.Context, options *ListOptio
 }
 
 func (s *accessTokenServiceHandler) Create(ctx context.Context, name, accessToken, expirationDate string) error {
+	ctx = withOperation(ctx, "AccessTokenService", "Create")
+
 	path := fmt.Sprintf("%s/access/token", accessTokenBasePath)
 
 	createAccessTokenReq := struct {
@@ -71,6 +78,8 @@ func (s *accessTokenServiceHandler) Create(ctx context.Context, name, accessToke
 }
 
 func (s *accessTokenServiceHandler) Delete(ctx context.Context, accessTokenIdentifier string) error {
+	ctx = withOperation(ctx, "AccessTokenService", "Delete")
+
 	path := fmt.Sprintf("%s/access/token/%s", accessTokenBasePath, accessTokenIdentifier)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
@@ -82,6 +91,8 @@ func (s *accessTokenServiceHandler) Delete(ctx context.Context, accessTokenIdent
 }
 
 func (s *accessTokenServiceHandler) Update(ctx context.Context, accessTokenIdentifier, name, expirationDate string) error {
+	ctx = withOperation(ctx, "AccessTokenService", "Update")
+
 	path := fmt.Sprintf("%s/access/token/%s", accessTokenBasePath, accessTokenIdentifier)
 
 	updateAccessTokenReq := struct {
@@ -7,6 +7,7 @@ import (
 
 type AccountService interface {
 	Login(ctx context.Context, loginCredentials *LoginReq) (*Token, error)
+	RefreshToken(ctx context.Context, refreshToken string) (*Token, error)
 }
 
 type accountServiceHandler struct {
@@ -36,6 +37,8 @@ type TokenRoot struct {
 }
 
 func (a *accountServiceHandler) Login(ctx context.Context, loginCredentials *LoginReq) (*Token, error) {
+	ctx = withOperation(ctx, "AccountService", "Login")
+
 	req, err := a.client.NewRequest(ctx, http.MethodPost, "/apps/v2/auth/from/api", loginCredentials)
 	if err != nil {
 		return nil, err
@@ -48,3 +51,26 @@ func (a *accountServiceHandler) Login(ctx context.Context, loginCredentials *Log
 
 	return &token.Token, nil
 }
+
+// RefreshToken redeems the refresh token returned by Login for a new token.
+func (a *accountServiceHandler) RefreshToken(ctx context.Context, refreshToken string) (*Token, error) {
+	ctx = withOperation(ctx, "AccountService", "RefreshToken")
+
+	refreshReq := struct {
+		RefreshToken string `json:"refreshToken"`
+	}{
+		RefreshToken: refreshToken,
+	}
+
+	req, err := a.client.NewRequest(ctx, http.MethodPost, "/apps/v2/auth/refresh/token", refreshReq)
+	if err != nil {
+		return nil, err
+	}
+
+	token := new(TokenRoot)
+	if err = a.client.Do(ctx, req, token); err != nil {
+		return nil, err
+	}
+
+	return &token.Token, nil
+}
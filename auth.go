@@ -0,0 +1,228 @@
+package govpsie
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// authHeader carries the access token on every API request.
+	authHeader = "Vpsie-Auth"
+
+	// defaultTokenTTL is assumed when the API returns an expiry we cannot parse.
+	defaultTokenTTL = 10 * time.Minute
+
+	// tokenExpiryDelta renews tokens this long before they actually expire,
+	// or a quarter of their lifetime earlier for shorter lived tokens.
+	tokenExpiryDelta = time.Minute
+)
+
+// TokenSource obtains access tokens by logging in through AccountService.Login
+// with API credentials. Tokens are cached and renewed a minute before they
+// expire with AccountService.RefreshToken, falling back to logging in again
+// if the refresh fails. It is safe for concurrent use and implements
+// oauth2.TokenSource.
+type TokenSource struct {
+	account AccountService
+	creds   LoginReq
+
+	mu      sync.Mutex
+	token   *oauth2.Token
+	renewAt time.Time
+
+	// refresh is the refresh token of the last login, with its expiry.
+	refresh       string
+	refreshExpiry time.Time
+}
+
+var _ oauth2.TokenSource = &TokenSource{}
+
+// NewTokenSource returns a TokenSource logging in with clientID and
+// clientSecret through account.
+func NewTokenSource(account AccountService, clientID, clientSecret string) *TokenSource {
+	return &TokenSource{
+		account: account,
+		creds: LoginReq{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+		},
+	}
+}
+
+// Token returns a valid access token, logging in if needed.
+func (s *TokenSource) Token() (*oauth2.Token, error) {
+	return s.TokenContext(context.Background())
+}
+
+// TokenContext is like Token but uses ctx for the login request.
+func (s *TokenSource) TokenContext(ctx context.Context) (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && time.Now().Before(s.renewAt) {
+		return s.token, nil
+	}
+
+	ctx = withoutAuth(ctx)
+	var token *Token
+	if s.refresh != "" && (s.refreshExpiry.IsZero() || time.Now().Before(s.refreshExpiry)) {
+		token, _ = s.account.RefreshToken(ctx, s.refresh)
+	}
+	if token == nil || token.Access.Token == "" {
+		var err error
+		if token, err = s.account.Login(ctx, &s.creds); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	expiry := parseTokenExpiry(token.Access.Expires, now)
+	s.token = &oauth2.Token{
+		AccessToken: token.Access.Token,
+		Expiry:      expiry,
+	}
+	s.renewAt = expiry.Add(-min(tokenExpiryDelta, expiry.Sub(now)/4))
+
+	// A refresh may not return a new refresh token, the old one is kept.
+	if token.Refresh.Token != "" {
+		s.refresh = token.Refresh.Token
+		s.refreshExpiry = time.Time{}
+		if token.Refresh.Expires != "" {
+			s.refreshExpiry = parseTokenExpiry(token.Refresh.Expires, now)
+		}
+	}
+
+	return s.token, nil
+}
+
+// Invalidate drops the cached token if it is still accessToken, forcing the
+// next call to Token to renew it.
+func (s *TokenSource) Invalidate(accessToken string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && s.token.AccessToken == accessToken {
+		s.token = nil
+	}
+}
+
+// NewClientWithCredentials returns a client that authenticates its requests
+// with tokens obtained from clientID and clientSecret. Requests rejected with
+// 401 are retried once with a fresh token.
+func NewClientWithCredentials(httpClient *http.Client, clientID, clientSecret string) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	c := NewClient(nil)
+	source := NewTokenSource(c.Account, clientID, clientSecret)
+
+	authClient := *httpClient
+	authClient.Transport = &authTransport{
+		source: source,
+		base:   httpClient.Transport,
+	}
+	c.client = &authClient
+
+	return c
+}
+
+type skipAuthContextKey struct{}
+
+// withoutAuth marks ctx so that authTransport leaves the request untouched,
+// which the login request itself relies on.
+func withoutAuth(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipAuthContextKey{}, true)
+}
+
+// authTransport sets the Vpsie-Auth header from a TokenSource.
+type authTransport struct {
+	source *TokenSource
+	base   http.RoundTripper
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	// Requests that already carry credentials, e.g. set by a middleware,
+	// are passed through untouched.
+	if skip, _ := req.Context().Value(skipAuthContextKey{}).(bool); skip || req.Header.Get(authHeader) != "" {
+		return base.RoundTrip(req)
+	}
+
+	token, err := t.source.TokenContext(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := base.RoundTrip(authorize(req, token.AccessToken))
+	if err != nil || res.StatusCode != http.StatusUnauthorized {
+		return res, err
+	}
+
+	// The token may have been revoked or expired early, retry once with a
+	// fresh one if the body can be replayed.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return res, nil
+	}
+
+	t.source.Invalidate(token.AccessToken)
+	token, err = t.source.TokenContext(req.Context())
+	if err != nil {
+		return res, nil
+	}
+	res.Body.Close()
+
+	retry := authorize(req, token.AccessToken)
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+
+	return base.RoundTrip(retry)
+}
+
+// authorize returns a copy of req carrying accessToken, as RoundTrippers must
+// not modify the request they are given.
+func authorize(req *http.Request, accessToken string) *http.Request {
+	authReq := req.Clone(req.Context())
+	authReq.Header.Set(authHeader, accessToken)
+
+	return authReq
+}
+
+// parseTokenExpiry parses the Expires value returned by the login endpoint.
+// Dates without a zone are taken as UTC.
+func parseTokenExpiry(expires string, now time.Time) time.Time {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05", "2006-01-02T15:04:05"} {
+		if t, err := time.Parse(layout, expires); err == nil {
+			return t
+		}
+	}
+
+	if n, err := strconv.ParseInt(expires, 10, 64); err == nil {
+		switch {
+		case n > 1e12:
+			return time.UnixMilli(n)
+		case n > 1e9:
+			return time.Unix(n, 0)
+		case n > 0:
+			return now.Add(time.Duration(n) * time.Second)
+		}
+	}
+
+	if d, err := time.ParseDuration(expires); err == nil {
+		return now.Add(d)
+	}
+
+	return now.Add(defaultTokenTTL)
+}
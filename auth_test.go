@@ -0,0 +1,144 @@
+package govpsie
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClientWithCredentials(t *testing.T) {
+	var logins atomic.Int32
+	var rejectNext atomic.Bool
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/apps/v2/auth/from/api" {
+			n := logins.Add(1)
+			expires := time.Now().Add(time.Hour).Format(time.RFC3339)
+			fmt.Fprintf(w, `{"error":false,"token":{"access":{"token":"token-%d","expires":%q}}}`, n, expires)
+			return
+		}
+
+		if r.Header.Get(authHeader) == "" || rejectNext.CompareAndSwap(true, false) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":true,"message":"unauthorized"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"error":false,"data":[]}`))
+	}))
+	defer srv.Close()
+
+	client := NewClientWithCredentials(srv.Client(), "id", "secret")
+	if err := client.SetBaseURL(srv.URL); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.Server.List(context.Background(), nil); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := logins.Load(); got != 1 {
+		t.Fatalf("logins = %d, want 1", got)
+	}
+
+	rejectNext.Store(true)
+	if _, err := client.Server.List(context.Background(), nil); err != nil {
+		t.Fatalf("expected request to succeed after re-login: %v", err)
+	}
+	if got := logins.Load(); got != 2 {
+		t.Errorf("logins = %d, want 2", got)
+	}
+}
+
+func TestParseTokenExpiry(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	tests := map[string]time.Time{
+		"2024-05-01T10:00:00Z": time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		"1800000000":           time.Unix(1800000000, 0),
+		"3600":                 now.Add(time.Hour),
+		"garbage":              now.Add(defaultTokenTTL),
+	}
+
+	for in, want := range tests {
+		if got := parseTokenExpiry(in, now); !got.Equal(want) {
+			t.Errorf("parseTokenExpiry(%q) = %v, want %v", in, got, want)
+		}
+	}
+}
+
+// fakeAccount counts logins and refreshes, refreshing with refreshErr.
+type fakeAccount struct {
+	logins, refreshes int
+	refreshed         []string
+	refreshErr        error
+}
+
+func (a *fakeAccount) Login(ctx context.Context, creds *LoginReq) (*Token, error) {
+	a.logins++
+	return &Token{
+		// Shorter lived than tokenExpiryDelta.
+		Access:  TokenDetails{Token: fmt.Sprintf("login-%d", a.logins), Expires: "30"},
+		Refresh: TokenDetails{Token: fmt.Sprintf("refresh-%d", a.logins)},
+	}, nil
+}
+
+func (a *fakeAccount) RefreshToken(ctx context.Context, refreshToken string) (*Token, error) {
+	a.refreshes++
+	a.refreshed = append(a.refreshed, refreshToken)
+	if a.refreshErr != nil {
+		return nil, a.refreshErr
+	}
+	return &Token{Access: TokenDetails{Token: fmt.Sprintf("refreshed-%d", a.refreshes), Expires: "3600"}}, nil
+}
+
+func TestTokenSourceRenewal(t *testing.T) {
+	account := &fakeAccount{}
+	source := NewTokenSource(account, "id", "secret")
+
+	token := func(want string) {
+		t.Helper()
+		got, err := source.Token()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.AccessToken != want {
+			t.Errorf("token = %q, want %q", got.AccessToken, want)
+		}
+	}
+
+	// A token living less than tokenExpiryDelta is still cached.
+	token("login-1")
+	token("login-1")
+
+	// Once due, it is refreshed, keeping the refresh token of the login.
+	source.renewAt = time.Time{}
+	token("refreshed-1")
+	source.renewAt = time.Time{}
+	token("refreshed-2")
+	if want := []string{"refresh-1", "refresh-1"}; !reflect.DeepEqual(account.refreshed, want) {
+		t.Errorf("refreshed with %v, want %v", account.refreshed, want)
+	}
+
+	// A failed refresh falls back to logging in.
+	account.refreshErr = errors.New("refresh rejected")
+	source.Invalidate("refreshed-2")
+	token("login-2")
+	if account.logins != 2 {
+		t.Errorf("logins = %d, want 2", account.logins)
+	}
+}
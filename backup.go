@@ -10,6 +10,7 @@ var backupsPath = "/apps/v2"
 
 type BackupsService interface {
 	List(ctx context.Context, options *ListOptions) ([]Backup, error)
+	ListWithResponse(ctx context.Context, options *ListOptions) ([]Backup, *Response, error)
 	DeleteBackup(ctx context.Context, backupIdentifier, deleteReason, deleteNote string) error
 	CreateBackups(ctx context.Context, vmIdentifier, name, notes string) error
 	ListByServer(ctx context.Context, options *ListOptions, vmIdentifier string) ([]Backup, error)
@@ -45,7 +46,7 @@ var _ BackupsService = &backupsServiceHandler{}
 type ListBackupsRoot struct {
 	Error bool     `json:"error"`
 	Data  []Backup `json:"data"`
-	Total int      `json:"total "`
+	Total int      `json:"total"`
 }
 
 type GetBackupsRoot struct {
@@ -73,22 +74,35 @@ type Backup struct {
 }
 
 func (b *backupsServiceHandler) List(ctx context.Context, options *ListOptions) ([]Backup, error) {
-	path := fmt.Sprintf("%s/backups", backupsPath)
+	items, _, err := b.ListWithResponse(ctx, options)
+	return items, err
+}
+
+func (b *backupsServiceHandler) ListWithResponse(ctx context.Context, options *ListOptions) ([]Backup, *Response, error) {
+	ctx = withOperation(ctx, "BackupsService", "List")
+
+	path, err := addOptions(fmt.Sprintf("%s/backups", backupsPath), options)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := b.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	backups := new(ListBackupsRoot)
-	if err = b.client.Do(ctx, req, backups); err != nil {
-		return nil, err
+	resp, err := b.client.DoWithResponse(ctx, req, backups)
+	if err != nil {
+		return nil, resp, err
 	}
 
-	return backups.Data, nil
+	return backups.Data, resp, nil
 }
 
 func (b *backupsServiceHandler) DeleteBackup(ctx context.Context, backupIdentifier, deleteReason, deleteNote string) error {
+	ctx = withOperation(ctx, "BackupsService", "DeleteBackup")
+
 	path := fmt.Sprintf("%s/backup", backupsPath)
 
 	deleteReq := struct {
@@ -117,6 +131,8 @@ func (b *backupsServiceHandler) DeleteBackup(ctx context.Context, backupIdentifi
 }
 
 func (b *backupsServiceHandler) CreateBackups(ctx context.Context, vmIdentifier, name, notes string) error {
+	ctx = withOperation(ctx, "BackupsService", "CreateBackups")
+
 	path := fmt.Sprintf("%s/backup/add", backupsPath)
 
 	createBackupReq := struct {
@@ -138,7 +154,12 @@ func (b *backupsServiceHandler) CreateBackups(ctx context.Context, vmIdentifier,
 }
 
 func (b *backupsServiceHandler) ListByServer(ctx context.Context, options *ListOptions, serverId string) ([]Backup, error) {
-	path := fmt.Sprintf("%s/vm/backups/%s", backupsPath, serverId)
+	ctx = withOperation(ctx, "BackupsService", "ListByServer")
+
+	path, err := addOptions(fmt.Sprintf("%s/vm/backups/%s", backupsPath, serverId), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := b.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -154,6 +175,8 @@ func (b *backupsServiceHandler) ListByServer(ctx context.Context, options *ListO
 }
 
 func (b *backupsServiceHandler) CreateServerByBackup(ctx context.Context, backupIdentifier string) error {
+	ctx = withOperation(ctx, "BackupsService", "CreateServerByBackup")
+
 	path := fmt.Sprintf("%s/backups/create", backupsPath)
 
 	createServerReq := struct {
@@ -171,6 +194,8 @@ func (b *backupsServiceHandler) CreateServerByBackup(ctx context.Context, backup
 }
 
 func (b *backupsServiceHandler) Get(ctx context.Context, identifer string) (*Backup, error) {
+	ctx = withOperation(ctx, "BackupsService", "Get")
+
 	path := fmt.Sprintf("%s/backup/%s", backupsPath, identifer)
 
 	req, err := b.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -187,6 +212,8 @@ func (b *backupsServiceHandler) Get(ctx context.Context, identifer string) (*Bac
 }
 
 func (b *backupsServiceHandler) EnableAutoBackup(ctx context.Context, enableAutoReq *EnableAutoBackupReq) error {
+	ctx = withOperation(ctx, "BackupsService", "EnableAutoBackup")
+
 	path := fmt.Sprintf("%s/backups/enable/auto", backupsPath)
 
 	req, err := b.client.NewRequest(ctx, http.MethodPost, path, enableAutoReq)
@@ -199,6 +226,8 @@ func (b *backupsServiceHandler) EnableAutoBackup(ctx context.Context, enableAuto
 }
 
 func (b *backupsServiceHandler) Rename(ctx context.Context, backupIdentifier string, newName string) error {
+	ctx = withOperation(ctx, "BackupsService", "Rename")
+
 	path := fmt.Sprintf("%s/backups/update/name", backupsPath)
 
 	renameReq := struct {
@@ -266,7 +295,12 @@ type CreateBackupPolicyReq struct {
 }
 
 func (b *backupsServiceHandler) ListBackupPolicies(ctx context.Context, options *ListOptions) ([]BackupPolicyListDetail, error) {
-	path := fmt.Sprintf("%s/backups/policy/all", backupsPath)
+	ctx = withOperation(ctx, "BackupsService", "ListBackupPolicies")
+
+	path, err := addOptions(fmt.Sprintf("%s/backups/policy/all", backupsPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := b.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -282,6 +316,8 @@ func (b *backupsServiceHandler) ListBackupPolicies(ctx context.Context, options
 }
 
 func (b *backupsServiceHandler) GetBackupPolicy(ctx context.Context, identifier string) (*BackupPolicy, error) {
+	ctx = withOperation(ctx, "BackupsService", "GetBackupPolicy")
+
 	path := fmt.Sprintf("%s/backups/policy/%s", backupsPath, identifier)
 
 	req, err := b.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -298,6 +334,8 @@ func (b *backupsServiceHandler) GetBackupPolicy(ctx context.Context, identifier
 }
 
 func (b *backupsServiceHandler) CreateBackupPolicy(ctx context.Context, createReq *CreateBackupPolicyReq) error {
+	ctx = withOperation(ctx, "BackupsService", "CreateBackupPolicy")
+
 	path := fmt.Sprintf("%s/backups/policy/create", backupsPath)
 
 	req, err := b.client.NewRequest(ctx, http.MethodPost, path, createReq)
@@ -309,6 +347,8 @@ func (b *backupsServiceHandler) CreateBackupPolicy(ctx context.Context, createRe
 }
 
 func (b *backupsServiceHandler) DeleteBackupPolicy(ctx context.Context, policyId, identifier string) error {
+	ctx = withOperation(ctx, "BackupsService", "DeleteBackupPolicy")
+
 	path := fmt.Sprintf("%s/backup/policy/%s", backupsPath, identifier)
 
 	deleteBackup := struct {
@@ -326,6 +366,8 @@ func (b *backupsServiceHandler) DeleteBackupPolicy(ctx context.Context, policyId
 }
 
 func (b *backupsServiceHandler) ManageRetainBackupPolicy(ctx context.Context, policyId string, keep int) error {
+	ctx = withOperation(ctx, "BackupsService", "ManageRetainBackupPolicy")
+
 	path := fmt.Sprintf("%s/backups/policy/keep", backupsPath)
 
 	manageBackup := struct {
@@ -345,6 +387,8 @@ func (b *backupsServiceHandler) ManageRetainBackupPolicy(ctx context.Context, po
 }
 
 func (b *backupsServiceHandler) AttachBackupPolicy(ctx context.Context, policyId string, vms []string) error {
+	ctx = withOperation(ctx, "BackupsService", "AttachBackupPolicy")
+
 	path := fmt.Sprintf("%s/backups/policy/attach", backupsPath)
 
 	attachBackup := struct {
@@ -364,6 +408,8 @@ func (b *backupsServiceHandler) AttachBackupPolicy(ctx context.Context, policyId
 }
 
 func (b *backupsServiceHandler) DetachBackupPolicy(ctx context.Context, policyId string, vms []string) error {
+	ctx = withOperation(ctx, "BackupsService", "DetachBackupPolicy")
+
 	path := fmt.Sprintf("%s/backups/policy/detach", backupsPath)
 
 	detachBackup := struct {
@@ -26,7 +26,7 @@ var _ BillingService = &billingServiceHandler{}
 type ListInvoicesRoot struct {
 	Error bool      `json:"error"`
 	Data  []Invoice `json:"data"`
-	Total int       `json:"total "`
+	Total int       `json:"total"`
 }
 
 type Invoice struct {
@@ -160,7 +160,12 @@ type ListAppliedVouchersRoot struct {
 }
 
 func (s *billingServiceHandler) ListInvoices(ctx context.Context, options *ListOptions) ([]Invoice, error) {
-	path := fmt.Sprintf("%s/invoices", billingPath)
+	ctx = withOperation(ctx, "BillingService", "ListInvoices")
+
+	path, err := addOptions(fmt.Sprintf("%s/invoices", billingPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -176,7 +181,12 @@ func (s *billingServiceHandler) ListInvoices(ctx context.Context, options *ListO
 }
 
 func (s *billingServiceHandler) ListPurchaseLog(ctx context.Context, options *ListOptions) ([]PurchaseLog, error) {
-	path := fmt.Sprintf("%s/purchase/logs", billingPath)
+	ctx = withOperation(ctx, "BillingService", "ListPurchaseLog")
+
+	path, err := addOptions(fmt.Sprintf("%s/purchase/logs", billingPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -192,7 +202,12 @@ func (s *billingServiceHandler) ListPurchaseLog(ctx context.Context, options *Li
 }
 
 func (s *billingServiceHandler) ListEstimatedUsages(ctx context.Context, options *ListOptions) ([]EstimatedUsages, error) {
-	path := fmt.Sprintf("%s/estimated/usages", billingPath)
+	ctx = withOperation(ctx, "BillingService", "ListEstimatedUsages")
+
+	path, err := addOptions(fmt.Sprintf("%s/estimated/usages", billingPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -208,7 +223,12 @@ func (s *billingServiceHandler) ListEstimatedUsages(ctx context.Context, options
 }
 
 func (s *billingServiceHandler) ListAppliedVouchers(ctx context.Context, options *ListOptions) ([]AppliedVouchers, error) {
-	path := fmt.Sprintf("%s/coupons", billingPath)
+	ctx = withOperation(ctx, "BillingService", "ListAppliedVouchers")
+
+	path, err := addOptions(fmt.Sprintf("%s/coupons", billingPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -224,6 +244,8 @@ func (s *billingServiceHandler) ListAppliedVouchers(ctx context.Context, options
 }
 
 func (s *billingServiceHandler) ApplyVoucher(ctx context.Context, couponIdentifier string) error {
+	ctx = withOperation(ctx, "BillingService", "ApplyVoucher")
+
 	path := fmt.Sprintf("%s/coupon/add", billingPath)
 
 	applyReq := struct {
@@ -11,6 +11,7 @@ var bucketPath = "/apps/v2/bucket"
 
 type BucketService interface {
 	List(ctx context.Context, options *ListOptions) ([]Bucket, error)
+	ListWithResponse(ctx context.Context, options *ListOptions) ([]Bucket, *Response, error)
 	Get(ctx context.Context, id string) (*Bucket, error)
 	Create(ctx context.Context, createReq *CreateBucketReq) error
 	Delete(ctx context.Context, bucketId, reason, note string) error
@@ -29,7 +30,7 @@ var _ BucketService = &bucketServiceHandler{}
 type ListBucketRoot struct {
 	Error bool     `json:"error"`
 	Data  []Bucket `json:"data"`
-	Total int      `json:"total "`
+	Total int      `json:"total"`
 }
 
 type GetBucketRoot struct {
@@ -53,18 +54,19 @@ type FileListingStatusRoot struct {
 }
 
 type Bucket struct {
-	ID          int    `json:"id"`
-	UserId      int    `json:"user_id"`
-	AccessKey   string `json:"accessKey"`
-	SecretKey   string `json:"secretKey"`
-	BucketName  string `json:"bucketName"`
-	ProjectName string `json:"projectName"`
-	CreatedBy   string `json:"created_by"`
-	EndPoint    string `json:"endPoint"`
-	CreatedOn   string `json:"created_on"`
-	Identifier  string `json:"identifier"`
-	State       string `json:"state"`
-	Country     string `json:"country"`
+	ID          int     `json:"id"`
+	UserId      int     `json:"user_id"`
+	AccessKey   string  `json:"accessKey"`
+	SecretKey   string  `json:"secretKey"`
+	BucketName  string  `json:"bucketName"`
+	ProjectName string  `json:"projectName"`
+	CreatedBy   string  `json:"created_by"`
+	EndPoint    string  `json:"endPoint"`
+	CreatedOn   string  `json:"created_on"`
+	Identifier  string  `json:"identifier"`
+	State       string  `json:"state"`
+	Country     string  `json:"country"`
+	Tags        TagList `json:"tags,omitempty"`
 }
 
 type BucketKey struct {
@@ -81,22 +83,35 @@ type ListBucketKeysRoot struct {
 }
 
 func (s *bucketServiceHandler) List(ctx context.Context, options *ListOptions) ([]Bucket, error) {
-	path := fmt.Sprintf("%s", bucketsPath)
+	items, _, err := s.ListWithResponse(ctx, options)
+	return items, err
+}
+
+func (s *bucketServiceHandler) ListWithResponse(ctx context.Context, options *ListOptions) ([]Bucket, *Response, error) {
+	ctx = withOperation(ctx, "BucketService", "List")
+
+	path, err := addOptions(bucketsPath, options)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var root ListBucketRoot
-	if err := s.client.Do(ctx, req, &root); err != nil {
-		return nil, err
+	resp, err := s.client.DoWithResponse(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
 	}
 
-	return root.Data, nil
+	return root.Data, resp, nil
 }
 
 func (s *bucketServiceHandler) Get(ctx context.Context, id string) (*Bucket, error) {
+	ctx = withOperation(ctx, "BucketService", "Get")
+
 	path := fmt.Sprintf("%s", bucketPath)
 
 	getReq := struct {
@@ -120,6 +135,8 @@ func (s *bucketServiceHandler) Get(ctx context.Context, id string) (*Bucket, err
 }
 
 func (s *bucketServiceHandler) Create(ctx context.Context, createReq *CreateBucketReq) error {
+	ctx = withOperation(ctx, "BucketService", "Create")
+
 	path := fmt.Sprintf("%s/create", bucketPath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, createReq)
@@ -131,6 +148,8 @@ func (s *bucketServiceHandler) Create(ctx context.Context, createReq *CreateBuck
 }
 
 func (s *bucketServiceHandler) Delete(ctx context.Context, buckId, reason, note string) error {
+	ctx = withOperation(ctx, "BucketService", "Delete")
+
 	path := fmt.Sprintf("%s/delete", bucketPath)
 
 	deleteReq := struct {
@@ -160,6 +179,8 @@ func (s *bucketServiceHandler) Delete(ctx context.Context, buckId, reason, note
 }
 
 func (s *bucketServiceHandler) GenerateKey(ctx context.Context, keyName string) error {
+	ctx = withOperation(ctx, "BucketService", "GenerateKey")
+
 	path := fmt.Sprintf("%s/generate/keys", bucketPath)
 
 	generateKeyReq := struct {
@@ -177,6 +198,8 @@ func (s *bucketServiceHandler) GenerateKey(ctx context.Context, keyName string)
 }
 
 func (s *bucketServiceHandler) CheckFileListingStatus(ctx context.Context, bucketId string) (bool, error) {
+	ctx = withOperation(ctx, "BucketService", "CheckFileListingStatus")
+
 	path := fmt.Sprintf("%s/listings/%s", bucketPath, bucketId)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -193,6 +216,8 @@ func (s *bucketServiceHandler) CheckFileListingStatus(ctx context.Context, bucke
 }
 
 func (s *bucketServiceHandler) ToggleFileListing(ctx context.Context, bucketId string, fileListing bool) (bool, error) {
+	ctx = withOperation(ctx, "BucketService", "ToggleFileListing")
+
 	path := fmt.Sprintf("%s/listings/%s", bucketPath, bucketId)
 
 	toogleReq := struct {
@@ -215,6 +240,8 @@ func (s *bucketServiceHandler) ToggleFileListing(ctx context.Context, bucketId s
 }
 
 func (s *bucketServiceHandler) ListBucketKeys(ctx context.Context) ([]BucketKey, error) {
+	ctx = withOperation(ctx, "BucketService", "ListBucketKeys")
+
 	path := fmt.Sprintf("%s/keys", bucketPath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
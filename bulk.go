@@ -0,0 +1,259 @@
+package govpsie
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const defaultBulkConcurrency = 4
+
+// BulkAction is an action BulkServerAction applies to every server.
+type BulkAction string
+
+const (
+	BulkStart        BulkAction = "start"
+	BulkStop         BulkAction = "stop"
+	BulkRestart      BulkAction = "restart"
+	BulkLock         BulkAction = "lock"
+	BulkUnlock       BulkAction = "unlock"
+	BulkAddSSHKey    BulkAction = "add_ssh_key"
+	BulkAddScript    BulkAction = "add_script"
+	BulkAddTags      BulkAction = "add_tags"
+	BulkEnableIPv4   BulkAction = "enable_ipv4"
+	BulkEnableIPv6   BulkAction = "enable_ipv6"
+	BulkResetNetwork BulkAction = "reset_network"
+)
+
+// BulkStatus is the outcome of a bulk action on one server.
+type BulkStatus string
+
+const (
+	BulkSucceeded BulkStatus = "succeeded"
+	BulkFailed    BulkStatus = "failed"
+	BulkSkipped   BulkStatus = "skipped"
+)
+
+var (
+	// ErrBulkAborted is the reason servers are skipped after a failure
+	// with BulkOptions.StopOnError.
+	ErrBulkAborted = errors.New("vpsie: bulk action aborted after a failure")
+
+	// ErrBulkDuplicate is the reason repeated identifiers are skipped.
+	ErrBulkDuplicate = errors.New("vpsie: duplicate server identifier")
+)
+
+// BulkOptions configures BulkServerAction. A nil *BulkOptions uses the
+// defaults.
+type BulkOptions struct {
+	// Concurrency is the number of servers handled at once, 4 by default.
+	// Requests remain subject to the client rate limiter and retry policy.
+	Concurrency int
+
+	// StopOnError stops starting new actions after the first failure. The
+	// remaining servers are reported as skipped; actions already running
+	// complete.
+	StopOnError bool
+
+	// Arguments of BulkAddSSHKey, BulkAddScript and BulkAddTags.
+	SSHKeyIdentifier string
+	ScriptIdentifier string
+	Tags             []string
+
+	// Progress, if set, is called with every result as it completes. It is
+	// called from the worker goroutines, one call at a time.
+	Progress func(BulkResult)
+}
+
+// BulkResult is the outcome of a bulk action on one server.
+type BulkResult struct {
+	Identifier string
+	Status     BulkStatus
+
+	// Err is the failure, or the reason the server was skipped.
+	Err error
+
+	Duration time.Duration
+}
+
+// BulkReport holds one result per server, in the order given.
+type BulkReport struct {
+	Action  BulkAction
+	Results []BulkResult
+}
+
+// Succeeded returns the identifiers of the servers the action succeeded on.
+func (r *BulkReport) Succeeded() []string {
+	return r.identifiers(BulkSucceeded)
+}
+
+// Skipped returns the identifiers of the servers left untouched.
+func (r *BulkReport) Skipped() []string {
+	return r.identifiers(BulkSkipped)
+}
+
+// Failed returns the results of the servers the action failed on.
+func (r *BulkReport) Failed() []BulkResult {
+	var failed []BulkResult
+	for _, res := range r.Results {
+		if res.Status == BulkFailed {
+			failed = append(failed, res)
+		}
+	}
+
+	return failed
+}
+
+// Err joins the failures, nil if there were none.
+func (r *BulkReport) Err() error {
+	var errs []error
+	for _, res := range r.Failed() {
+		errs = append(errs, fmt.Errorf("%s: %w", res.Identifier, res.Err))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *BulkReport) identifiers(status BulkStatus) []string {
+	var ids []string
+	for _, res := range r.Results {
+		if res.Status == status {
+			ids = append(ids, res.Identifier)
+		}
+	}
+
+	return ids
+}
+
+// bulkFunc returns the call applying action to one server.
+func (c *Client) bulkFunc(action BulkAction, opts *BulkOptions) (func(ctx context.Context, id string) error, error) {
+	s := c.Server
+
+	switch action {
+	case BulkStart:
+		return s.StartServer, nil
+	case BulkStop:
+		return s.StopServer, nil
+	case BulkRestart:
+		return s.RestartServer, nil
+	case BulkLock:
+		return s.Lock, nil
+	case BulkUnlock:
+		return s.UnLock, nil
+	case BulkEnableIPv4:
+		return s.EnableIpv4, nil
+	case BulkEnableIPv6:
+		return s.EnableIpv6, nil
+	case BulkResetNetwork:
+		return s.ResetNetwork, nil
+	case BulkAddSSHKey:
+		if opts.SSHKeyIdentifier == "" {
+			return nil, errors.New("vpsie: bulk add_ssh_key requires SSHKeyIdentifier")
+		}
+		return func(ctx context.Context, id string) error {
+			return s.AddSsh(ctx, id, opts.SSHKeyIdentifier)
+		}, nil
+	case BulkAddScript:
+		if opts.ScriptIdentifier == "" {
+			return nil, errors.New("vpsie: bulk add_script requires ScriptIdentifier")
+		}
+		return func(ctx context.Context, id string) error {
+			return s.AddScript(ctx, id, opts.ScriptIdentifier)
+		}, nil
+	case BulkAddTags:
+		if len(opts.Tags) == 0 {
+			return nil, errors.New("vpsie: bulk add_tags requires Tags")
+		}
+		return func(ctx context.Context, id string) error {
+			return s.AddTags(ctx, id, opts.Tags)
+		}, nil
+	}
+
+	return nil, fmt.Errorf("vpsie: unsupported bulk action %q", action)
+}
+
+// BulkServerAction applies action to every server, a few at a time, and
+// reports the outcome per server. The error is only set for invalid
+// arguments; failures are reported in BulkReport.Results. Servers not
+// started when ctx is done are skipped with its error.
+func (c *Client) BulkServerAction(ctx context.Context, action BulkAction, vmIdentifiers []string, opts *BulkOptions) (*BulkReport, error) {
+	if opts == nil {
+		opts = &BulkOptions{}
+	}
+	apply, err := c.bulkFunc(action, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &BulkReport{Action: action, Results: make([]BulkResult, len(vmIdentifiers))}
+
+	var progressMu sync.Mutex
+	done := func(i int, res BulkResult) {
+		report.Results[i] = res
+		if opts.Progress != nil {
+			progressMu.Lock()
+			opts.Progress(res)
+			progressMu.Unlock()
+		}
+	}
+
+	jobs := make(chan int)
+	go func() {
+		defer close(jobs)
+
+		seen := make(map[string]bool, len(vmIdentifiers))
+		for i, id := range vmIdentifiers {
+			if seen[id] {
+				done(i, BulkResult{Identifier: id, Status: BulkSkipped, Err: ErrBulkDuplicate})
+				continue
+			}
+			seen[id] = true
+			jobs <- i
+		}
+	}()
+
+	var (
+		aborted atomic.Bool
+		wg      sync.WaitGroup
+	)
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultBulkConcurrency
+	}
+
+	for range min(concurrency, max(len(vmIdentifiers), 1)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				id := vmIdentifiers[i]
+				switch {
+				case aborted.Load():
+					done(i, BulkResult{Identifier: id, Status: BulkSkipped, Err: ErrBulkAborted})
+					continue
+				case ctx.Err() != nil:
+					done(i, BulkResult{Identifier: id, Status: BulkSkipped, Err: ctx.Err()})
+					continue
+				}
+
+				start := time.Now()
+				res := BulkResult{Identifier: id, Status: BulkSucceeded}
+				if err := apply(ctx, id); err != nil {
+					res.Status, res.Err = BulkFailed, err
+					if opts.StopOnError {
+						aborted.Store(true)
+					}
+				}
+				res.Duration = time.Since(start)
+				done(i, res)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return report, nil
+}
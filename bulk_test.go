@@ -0,0 +1,114 @@
+package govpsie_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/vpsieinc/govpsie"
+	"github.com/vpsieinc/govpsie/govpsiemock"
+)
+
+func newBulkClient() (*govpsie.Client, *govpsiemock.Services) {
+	client := govpsie.NewClient(nil)
+	mocks := govpsiemock.NewServices()
+	mocks.Install(client)
+
+	return client, mocks
+}
+
+func TestBulkServerAction(t *testing.T) {
+	client, mocks := newBulkClient()
+
+	var running, peak atomic.Int32
+	mocks.Server.StartServerFunc = func(ctx context.Context, id string) error {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		if id == "vm-3" {
+			return errors.New("boom")
+		}
+		return nil
+	}
+
+	ids := []string{"vm-1", "vm-2", "vm-3", "vm-4", "vm-1", "vm-5", "vm-6"}
+	report, err := client.BulkServerAction(context.Background(), govpsie.BulkStart, ids, &govpsie.BulkOptions{Concurrency: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := report.Succeeded(), []string{"vm-1", "vm-2", "vm-4", "vm-5", "vm-6"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Succeeded = %v, want %v", got, want)
+	}
+	if failed := report.Failed(); len(failed) != 1 || failed[0].Identifier != "vm-3" {
+		t.Errorf("Failed = %+v", failed)
+	}
+	if res := report.Results[4]; res.Status != govpsie.BulkSkipped || !errors.Is(res.Err, govpsie.ErrBulkDuplicate) {
+		t.Errorf("duplicate result = %+v", res)
+	}
+	if report.Err() == nil {
+		t.Error("Err() = nil, want the vm-3 failure")
+	}
+	if p := peak.Load(); p > 2 {
+		t.Errorf("ran %d actions at once, want at most 2", p)
+	}
+	if got := len(mocks.Server.CallsTo("StartServer")); got != 6 {
+		t.Errorf("StartServer called %d times, want 6", got)
+	}
+}
+
+func TestBulkServerActionStopOnError(t *testing.T) {
+	client, mocks := newBulkClient()
+	mocks.Server.AddSshFunc = func(ctx context.Context, id, key string) error {
+		if key != "key-1" {
+			t.Errorf("key = %q", key)
+		}
+		if id == "vm-2" {
+			return fmt.Errorf("locked")
+		}
+		return nil
+	}
+
+	var progress int
+	report, err := client.BulkServerAction(context.Background(), govpsie.BulkAddSSHKey, []string{"vm-1", "vm-2", "vm-3", "vm-4"}, &govpsie.BulkOptions{
+		Concurrency:      1,
+		StopOnError:      true,
+		SSHKeyIdentifier: "key-1",
+		Progress:         func(govpsie.BulkResult) { progress++ },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := report.Skipped(), []string{"vm-3", "vm-4"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Skipped = %v, want %v", got, want)
+	}
+	if !errors.Is(report.Results[3].Err, govpsie.ErrBulkAborted) {
+		t.Errorf("skip reason = %v", report.Results[3].Err)
+	}
+	if progress != 4 {
+		t.Errorf("progress called %d times, want 4", progress)
+	}
+}
+
+func TestBulkServerActionValidatesArguments(t *testing.T) {
+	client, _ := newBulkClient()
+
+	if _, err := client.BulkServerAction(context.Background(), govpsie.BulkAddSSHKey, []string{"vm-1"}, nil); err == nil {
+		t.Error("add_ssh_key without a key succeeded")
+	}
+	if _, err := client.BulkServerAction(context.Background(), "reinstall", []string{"vm-1"}, nil); err == nil {
+		t.Error("unknown action succeeded")
+	}
+}
@@ -0,0 +1,342 @@
+// Package cassette records VPSie API interactions to a file and replays them,
+// so that tests can run against responses captured once from a real account.
+//
+//	rec, err := cassette.New("testdata/servers.yaml", cassette.ModeReplay)
+//	if err != nil {
+//		return err
+//	}
+//	defer rec.Stop()
+//
+//	client := govpsie.NewClient(rec.Client())
+//
+// Credentials are scrubbed before anything is written: sensitive headers,
+// query parameters and JSON body fields are replaced by [REDACTED]. As login
+// responses lose their tokens, replayed clients should authenticate with a
+// static token rather than with API credentials.
+//
+// Cassettes ending in .yaml or .yml are written as YAML, others as JSON.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/vpsieinc/govpsie/internal/redact"
+	"gopkg.in/yaml.v3"
+)
+
+// Mode selects whether a Recorder talks to the API or replays a cassette.
+type Mode int
+
+const (
+	// ModeReplay serves responses from the cassette and fails on requests
+	// it does not contain.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends requests to the API and records them. The cassette
+	// is written by Stop.
+	ModeRecord
+)
+
+// ErrNoMatch is returned in replay mode for requests missing from the
+// cassette.
+var ErrNoMatch = errors.New("cassette: no matching interaction")
+
+// Cassette is the content of a cassette file.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions" yaml:"interactions"`
+}
+
+// Interaction is one recorded request and its response.
+type Interaction struct {
+	Request  Request  `json:"request" yaml:"request"`
+	Response Response `json:"response" yaml:"response"`
+}
+
+// Request is a recorded request.
+type Request struct {
+	Method string      `json:"method" yaml:"method"`
+	URL    string      `json:"url" yaml:"url"`
+	Header http.Header `json:"header,omitempty" yaml:"header,omitempty"`
+	Body   string      `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"status_code" yaml:"status_code"`
+	Header     http.Header `json:"header,omitempty" yaml:"header,omitempty"`
+	Body       string      `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+// Load reads a cassette file.
+func Load(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var c Cassette
+	if isYAML(path) {
+		err = yaml.Unmarshal(data, &c)
+	} else {
+		err = json.Unmarshal(data, &c)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cassette: parse %s: %w", path, err)
+	}
+
+	return &c, nil
+}
+
+// Save writes c to path, creating the parent directories.
+func (c *Cassette) Save(path string) error {
+	var (
+		data []byte
+		err  error
+	)
+	if isYAML(path) {
+		data, err = yaml.Marshal(c)
+	} else {
+		data, err = json.MarshalIndent(c, "", "  ")
+		data = append(data, '\n')
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
+
+func isYAML(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// Matcher reports whether a recorded request matches req, whose body has
+// already been read and scrubbed.
+type Matcher func(req *http.Request, body string, recorded Request) bool
+
+// DefaultMatcher matches on method, path, query and body. The host is
+// ignored so that cassettes recorded against a staging API replay with any
+// base URL.
+func DefaultMatcher(req *http.Request, body string, recorded Request) bool {
+	if req.Method != recorded.Method {
+		return false
+	}
+
+	u, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+	if u.Path != req.URL.Path || u.Query().Encode() != scrubQuery(req.URL.Query()).Encode() {
+		return false
+	}
+
+	return body == recorded.Body
+}
+
+// Option configures a Recorder.
+type Option func(*Recorder)
+
+// WithTransport sets the transport used in record mode,
+// http.DefaultTransport by default.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(r *Recorder) {
+		r.transport = rt
+	}
+}
+
+// WithMatcher replaces DefaultMatcher.
+func WithMatcher(m Matcher) Option {
+	return func(r *Recorder) {
+		r.matcher = m
+	}
+}
+
+// Recorder is an http.RoundTripper recording or replaying a cassette. It is
+// safe for concurrent use.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	matcher   Matcher
+
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+var _ http.RoundTripper = &Recorder{}
+
+// New returns a Recorder for the cassette at path. In replay mode the
+// cassette must exist.
+func New(path string, mode Mode, opts ...Option) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		transport: http.DefaultTransport,
+		matcher:   DefaultMatcher,
+		cassette:  &Cassette{},
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if mode == ModeReplay {
+		c, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+		r.cassette = c
+		r.used = make([]bool, len(c.Interactions))
+	}
+
+	return r, nil
+}
+
+// Client returns an HTTP client using r as its transport, to be passed to
+// govpsie.NewClient.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Stop writes the cassette in record mode. It does nothing in replay mode.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cassette.Save(r.path)
+}
+
+// RoundTrip records or replays req.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	scrubbed := string(redact.JSON(body))
+
+	if r.mode == ModeReplay {
+		return r.replay(req, scrubbed)
+	}
+
+	out := req.Clone(req.Context())
+	if body != nil {
+		out.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	res, err := r.transport.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	u := *req.URL
+	u.RawQuery = scrubQuery(u.Query()).Encode()
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    u.String(),
+			Header: redact.Header(req.Header),
+			Body:   scrubbed,
+		},
+		Response: Response{
+			StatusCode: res.StatusCode,
+			Header:     redact.Header(res.Header),
+			Body:       string(redact.JSON(resBody)),
+		},
+	})
+	r.mu.Unlock()
+
+	return res, nil
+}
+
+// replay serves the first unused interaction matching req.
+func (r *Recorder) replay(req *http.Request, body string) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, in := range r.cassette.Interactions {
+		if r.used[i] || !r.matcher(req, body, in.Request) {
+			continue
+		}
+		r.used[i] = true
+
+		header := in.Response.Header.Clone()
+		if header == nil {
+			header = make(http.Header)
+		}
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(in.Response.Body)),
+			ContentLength: int64(len(in.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, noMatchError{fmt.Errorf("%w: %s %s", ErrNoMatch, req.Method, req.URL.Path)}
+}
+
+// noMatchError wraps ErrNoMatch so that govpsie.DefaultCheckRetry fails at
+// once instead of retrying a request the cassette will never match.
+type noMatchError struct {
+	err error
+}
+
+func (e noMatchError) Error() string   { return e.err.Error() }
+func (e noMatchError) Unwrap() error   { return e.err }
+func (e noMatchError) Retryable() bool { return false }
+
+// readBody reads and closes the request body, if any.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	defer req.Body.Close()
+
+	return io.ReadAll(req.Body)
+}
+
+// scrubQuery returns q with the values of sensitive parameters replaced.
+func scrubQuery(q url.Values) url.Values {
+	for key, values := range q {
+		if redact.IsSensitiveKey(key) {
+			for i := range values {
+				values[i] = redact.Placeholder
+			}
+		}
+	}
+
+	return q
+}
@@ -0,0 +1,128 @@
+package cassette_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vpsieinc/govpsie"
+	"github.com/vpsieinc/govpsie/cassette"
+	"github.com/vpsieinc/govpsie/vpsietest"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	for _, name := range []string{"servers.json", "servers.yaml"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), name)
+			ctx := context.Background()
+
+			srv := vpsietest.NewServer()
+			defer srv.Close()
+			srv.AddServer(govpsie.VmData{Hostname: "web-1"})
+
+			rec, err := cassette.New(path, cassette.ModeRecord)
+			if err != nil {
+				t.Fatal(err)
+			}
+			client, err := srv.NewClient(govpsie.WithHTTPClient(rec.Client()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			recorded, err := client.Server.List(ctx, nil)
+			if err != nil {
+				t.Fatalf("List: %v", err)
+			}
+			if err := rec.Stop(); err != nil {
+				t.Fatalf("Stop: %v", err)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.Contains(string(data), srv.Token) {
+				t.Error("cassette contains the access token")
+			}
+
+			rec, err = cassette.New(path, cassette.ModeReplay)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Unmatched requests must not be retried by the default policy.
+			policy := govpsie.DefaultRetryPolicy()
+			var retried bool
+			policy.CheckRetry = func(res *http.Response, err error) bool {
+				retried = retried || govpsie.DefaultCheckRetry(res, err)
+				return false
+			}
+			client, err = govpsie.New(govpsie.WithHTTPClient(rec.Client()), govpsie.WithToken("replay"), govpsie.WithRetryPolicy(policy))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			replayed, err := client.Server.List(ctx, nil)
+			if err != nil {
+				t.Fatalf("replayed List: %v", err)
+			}
+			if len(replayed) != 1 || replayed[0].Identifier != recorded[0].Identifier {
+				t.Errorf("replayed = %+v, want %+v", replayed, recorded)
+			}
+
+			// Each interaction is served once.
+			if _, err := client.Server.List(ctx, nil); !errors.Is(err, cassette.ErrNoMatch) {
+				t.Errorf("second List err = %v, want ErrNoMatch", err)
+			}
+			if retried {
+				t.Error("DefaultCheckRetry retries ErrNoMatch")
+			}
+		})
+	}
+}
+
+func TestRecordScrubsBodies(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "login.json")
+
+	srv := vpsietest.NewServer()
+	defer srv.Close()
+
+	rec, err := cassette.New(path, cassette.ModeRecord, cassette.WithTransport(srv.HTTPClient().Transport))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := govpsie.New(
+		govpsie.WithBaseURL(srv.URL),
+		govpsie.WithHTTPClient(rec.Client()),
+		govpsie.WithRetryPolicy(nil),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Account.Login(context.Background(), &govpsie.LoginReq{
+		ClientID:     vpsietest.ClientID,
+		ClientSecret: vpsietest.ClientSecret,
+	}); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := cassette.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Interactions) != 1 {
+		t.Fatalf("recorded %d interactions, want 1", len(c.Interactions))
+	}
+	in := c.Interactions[0]
+	if strings.Contains(in.Request.Body, vpsietest.ClientSecret) {
+		t.Errorf("request body not scrubbed: %s", in.Request.Body)
+	}
+	if strings.Contains(in.Response.Body, srv.Token) {
+		t.Errorf("response body not scrubbed: %s", in.Response.Body)
+	}
+}
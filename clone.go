@@ -0,0 +1,459 @@
+package govpsie
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CloneSource is the artifact a server is cloned from.
+type CloneSource string
+
+const (
+	CloneFromSnapshot CloneSource = "snapshot"
+	CloneFromBackup   CloneSource = "backup"
+)
+
+// artifactStates classifies the states of snapshots and backups. The API
+// does not document them, so the usual spellings are accepted, ignoring
+// case, spaces, dashes and underscores. An artifact in an unknown state is
+// taken as ready once it reports a checksum.
+var artifactStates = map[string]artifactState{
+	"completed":  artifactReady,
+	"complete":   artifactReady,
+	"done":       artifactReady,
+	"success":    artifactReady,
+	"successful": artifactReady,
+	"finished":   artifactReady,
+	"ready":      artifactReady,
+	"available":  artifactReady,
+	"active":     artifactReady,
+	"created":    artifactReady,
+
+	"pending":    artifactBusy,
+	"queued":     artifactBusy,
+	"creating":   artifactBusy,
+	"inprogress": artifactBusy,
+	"processing": artifactBusy,
+	"running":    artifactBusy,
+	"uploading":  artifactBusy,
+
+	"failed":    artifactFailed,
+	"failure":   artifactFailed,
+	"error":     artifactFailed,
+	"errored":   artifactFailed,
+	"cancelled": artifactFailed,
+	"canceled":  artifactFailed,
+}
+
+type artifactState int
+
+const (
+	artifactUnknown artifactState = iota
+	artifactReady
+	artifactBusy
+	artifactFailed
+)
+
+// classifyArtifact returns the artifactState of a snapshot or backup.
+func classifyArtifact(state, checksum string) artifactState {
+	key := strings.NewReplacer(" ", "", "_", "", "-", "").Replace(strings.ToLower(state))
+	if s, ok := artifactStates[key]; ok {
+		return s
+	}
+	if checksum != "" {
+		return artifactReady
+	}
+
+	return artifactUnknown
+}
+
+// cleanupTimeout bounds the removal of a temporary artifact, which runs even
+// when the clone context is done.
+const cleanupTimeout = time.Minute
+
+// CloneOptions configures CloneServer. A nil *CloneOptions makes one copy
+// from a temporary snapshot.
+type CloneOptions struct {
+	// Source is the kind of artifact, a snapshot by default.
+	Source CloneSource
+
+	// ArtifactIdentifier clones from an existing snapshot or backup, which
+	// is kept, instead of taking a new one.
+	ArtifactIdentifier string
+
+	// Count is the number of copies, 1 by default.
+	Count int
+
+	// Hostnames names the copies. Missing names default to the source
+	// hostname followed by "-clone-N".
+	Hostnames []string
+
+	// Tags of the copies, those of the source by default.
+	Tags []string
+
+	// ProjectID of the copies, that of the source by default. Backups are
+	// restored in the project of the source, so another project requires
+	// a snapshot.
+	ProjectID string
+
+	// ResourceIdentifier is the plan of copies made from a snapshot. By
+	// default the cheapest plan at least as large as the source is used.
+	ResourceIdentifier string
+
+	// SameVPC attaches the copies to the VPCs of the source.
+	SameVPC bool
+
+	// SameFirewallGroups assigns the copies to the firewall groups of the
+	// source.
+	SameFirewallGroups bool
+
+	// KeepArtifact keeps the snapshot or backup taken for the clone.
+	KeepArtifact bool
+
+	// Wait configures the waits for the artifact and each copy.
+	Wait *WaitOptions
+}
+
+// CloneResult is the outcome of CloneServer.
+type CloneResult struct {
+	// ArtifactIdentifier is the snapshot or backup the copies were made
+	// from, set as soon as the artifact is listed.
+	ArtifactIdentifier string
+
+	// ArtifactDeleted reports whether the temporary artifact was removed.
+	ArtifactDeleted bool
+
+	// Servers are the copies created, in the order of their hostnames.
+	Servers []VmData
+}
+
+// CloneServer copies a server: it snapshots or backs up the source, waits
+// for the artifact, creates the copies one at a time with their hostname,
+// tags and project, optionally attaches them to the VPCs and firewall groups
+// of the source, then removes the temporary artifact.
+//
+// On failure the copies already created are returned with the error, and
+// the artifact is still removed, unless a copy that did not become ready in
+// time may still be built from it. Such a copy is returned too if its
+// identifier is known.
+func (c *Client) CloneServer(ctx context.Context, sourceID string, opts *CloneOptions) (result *CloneResult, err error) {
+	var o CloneOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Source == "" {
+		o.Source = CloneFromSnapshot
+	}
+	if o.Count <= 0 {
+		o.Count = max(1, len(o.Hostnames))
+	}
+	if o.Source != CloneFromSnapshot && o.Source != CloneFromBackup {
+		return nil, fmt.Errorf("vpsie: unknown clone source %q", o.Source)
+	}
+
+	source, err := c.Server.GetServerDetails(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	vm := source.VmData
+
+	if o.Tags == nil {
+		o.Tags = source.TagNames()
+	}
+	sourceProject := strconv.FormatInt(vm.ProjectID, 10)
+	if o.Source == CloneFromBackup && o.ProjectID != "" && o.ProjectID != sourceProject {
+		return nil, errors.New("vpsie: backups are restored in the project of the source, clone from a snapshot to change it")
+	}
+	if o.ProjectID == "" && vm.ProjectID != 0 {
+		o.ProjectID = sourceProject
+	}
+
+	hostnames := make([]string, o.Count)
+	for i := range hostnames {
+		if i < len(o.Hostnames) && o.Hostnames[i] != "" {
+			hostnames[i] = o.Hostnames[i]
+		} else {
+			hostnames[i] = fmt.Sprintf("%s-clone-%d", vm.Hostname, i+1)
+		}
+	}
+
+	if o.Source == CloneFromSnapshot && o.ResourceIdentifier == "" {
+		plans, err := c.Server.ListResourcePlans(ctx, vm.DcIdentifier)
+		if err != nil {
+			return nil, err
+		}
+		plan, err := SelectResourcePlan(plans, PlanRequirements{MinCPU: int(vm.Cpu), MinRAM: int(vm.Ram), MinSsd: int(vm.Ssd)})
+		if err != nil {
+			return nil, fmt.Errorf("vpsie: plan for clones of %s: %w", vm.Hostname, err)
+		}
+		o.ResourceIdentifier = plan.Identifier
+	}
+
+	// inUse is set when a copy may still be built from the artifact.
+	var inUse bool
+
+	result = &CloneResult{ArtifactIdentifier: o.ArtifactIdentifier}
+	if result.ArtifactIdentifier == "" {
+		var artifact *cloneArtifact
+		if artifact, err = c.createCloneArtifact(ctx, &vm, o.Source); err != nil {
+			return nil, err
+		}
+		if !o.KeepArtifact {
+			defer func() {
+				if inUse {
+					return
+				}
+
+				ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cleanupTimeout)
+				defer cancel()
+
+				cleanupErr := artifact.delete(ctx)
+				result.ArtifactIdentifier = artifact.identifier
+				result.ArtifactDeleted = cleanupErr == nil
+				err = errors.Join(err, cleanupErr)
+			}()
+		}
+
+		err = artifact.wait(ctx, o.Wait)
+		result.ArtifactIdentifier = artifact.identifier
+		if err != nil {
+			return result, err
+		}
+	}
+
+	var firewallGroups []string
+	if o.SameFirewallGroups {
+		if firewallGroups, err = c.serverFirewallGroups(ctx, vm.Identifier); err != nil {
+			return result, err
+		}
+	}
+
+	for _, hostname := range hostnames {
+		clone, building, err := c.createClone(ctx, &vm, result.ArtifactIdentifier, hostname, o)
+		if clone != nil {
+			result.Servers = append(result.Servers, *clone)
+		}
+		if err != nil {
+			inUse = building
+			return result, fmt.Errorf("vpsie: clone %s: %w", hostname, err)
+		}
+
+		if o.SameVPC {
+			for _, ip := range source.PrivateIpData {
+				if ip.VpcID == 0 {
+					continue
+				}
+				err := c.VPC.AssignServer(ctx, &AssignServerReq{VmIdentifier: clone.Identifier, VpcID: int(ip.VpcID), DcIdentifier: clone.DcIdentifier})
+				if err != nil {
+					return result, fmt.Errorf("vpsie: clone %s: vpc %d: %w", hostname, ip.VpcID, err)
+				}
+			}
+		}
+		for _, group := range firewallGroups {
+			if err := c.FirewallGroup.AssignToVpsie(ctx, group, clone.Identifier); err != nil {
+				return result, fmt.Errorf("vpsie: clone %s: firewall group %s: %w", hostname, group, err)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// cloneArtifact is a snapshot or backup taken for a clone.
+type cloneArtifact struct {
+	client *Client
+	source CloneSource
+	name   string
+
+	// identifier is empty until the artifact is listed.
+	identifier string
+
+	// find looks the artifact up by name, returning an empty identifier if
+	// it is not listed.
+	find func(ctx context.Context) (id, state, checksum string, err error)
+}
+
+// createCloneArtifact takes a snapshot or backup of vm.
+func (c *Client) createCloneArtifact(ctx context.Context, vm *VmData, source CloneSource) (*cloneArtifact, error) {
+	a := &cloneArtifact{
+		client: c,
+		source: source,
+		name:   fmt.Sprintf("clone-%s-%s", vm.Hostname, newProcessID()[:8]),
+	}
+	note := "Temporary " + string(source) + " for a clone of " + vm.Hostname
+
+	switch source {
+	case CloneFromSnapshot:
+		if err := c.Snapshot.Create(ctx, a.name, vm.Identifier, note); err != nil {
+			return nil, err
+		}
+		a.find = func(ctx context.Context) (string, string, string, error) {
+			snapshots, err := c.Snapshot.ListByVm(ctx, nil, vm.Identifier)
+			for _, s := range snapshots {
+				if s.Name == a.name {
+					return s.Identifier, s.State, s.BackupSHA1, nil
+				}
+			}
+			return "", "", "", err
+		}
+
+	case CloneFromBackup:
+		if err := c.Backup.CreateBackups(ctx, vm.Identifier, a.name, note); err != nil {
+			return nil, err
+		}
+		a.find = func(ctx context.Context) (string, string, string, error) {
+			backups, err := c.Backup.ListByServer(ctx, nil, vm.Identifier)
+			for _, b := range backups {
+				if b.Name == a.name {
+					return b.Identifier, b.State, b.BackupSHA1, nil
+				}
+			}
+			return "", "", "", err
+		}
+	}
+
+	return a, nil
+}
+
+// wait polls the artifact until it is usable.
+func (a *cloneArtifact) wait(ctx context.Context, opts *WaitOptions) error {
+	w := opts.withDefaults()
+	ctx, cancel := context.WithTimeout(ctx, w.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	interval := w.MinInterval
+	lastState := "not listed"
+	for {
+		id, state, checksum, err := a.find(ctx)
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+		if id != "" {
+			a.identifier = id
+			lastState = fmt.Sprintf("state %q", state)
+			switch classifyArtifact(state, checksum) {
+			case artifactReady:
+				return nil
+			case artifactFailed:
+				return fmt.Errorf("vpsie: %s %s failed with %s", a.source, a.name, lastState)
+			}
+		}
+
+		if err := sleep(ctx, interval); err != nil {
+			return fmt.Errorf("vpsie: %s %s not ready after %s, %s: %w",
+				a.source, a.name, time.Since(start).Round(time.Second), lastState, err)
+		}
+		interval = min(time.Duration(float64(interval)*w.Multiplier), w.MaxInterval)
+	}
+}
+
+// delete removes the artifact, looking it up first if it was never listed.
+func (a *cloneArtifact) delete(ctx context.Context) error {
+	if a.identifier == "" {
+		id, _, _, err := a.find(ctx)
+		if err != nil {
+			return err
+		}
+		if id == "" {
+			return fmt.Errorf("vpsie: %s %s not found for removal", a.source, a.name)
+		}
+		a.identifier = id
+	}
+
+	const reason, note = "clone", "Temporary clone artifact"
+	if a.source == CloneFromBackup {
+		return a.client.Backup.DeleteBackup(ctx, a.identifier, reason, note)
+	}
+
+	return a.client.Snapshot.Delete(ctx, a.identifier, reason, note)
+}
+
+// createClone creates one copy of vm from the artifact and waits until it
+// runs with the requested hostname and tags. building reports that the copy
+// was requested but is not ready, and may still be built from the artifact;
+// the copy is then returned if its identifier is known.
+func (c *Client) createClone(ctx context.Context, vm *VmData, artifact, hostname string, o CloneOptions) (clone *VmData, building bool, err error) {
+	creation := &ServerCreation{
+		DcIdentifier: vm.DcIdentifier,
+		client:       c,
+	}
+
+	switch o.Source {
+	case CloneFromSnapshot:
+		req := &CreateServerRequest{
+			ResourceIdentifier: o.ResourceIdentifier,
+			// The custom image endpoint takes the snapshot as the OS.
+			OsIdentifier: artifact,
+			DcIdentifier: vm.DcIdentifier,
+			Hostname:     hostname,
+			ProjectID:    o.ProjectID,
+			ProcessID:    newProcessID(),
+		}
+		for i := range o.Tags {
+			req.Tags = append(req.Tags, &o.Tags[i])
+		}
+		if err := c.Image.CreateServerByImage(ctx, req); err != nil {
+			return nil, false, err
+		}
+		creation.ProcessID, creation.Hostname = req.ProcessID, hostname
+
+	case CloneFromBackup:
+		// The restored server keeps the hostname of the source and is not
+		// tracked in the pending queue, so the existing servers are listed
+		// before it is requested.
+		known, err := c.serverIdentifiers(ctx, vm.Hostname)
+		if err != nil {
+			return nil, false, err
+		}
+		if err := c.Backup.CreateServerByBackup(ctx, artifact); err != nil {
+			return nil, false, err
+		}
+		creation.Hostname, creation.known = vm.Hostname, known
+	}
+
+	if clone, err = creation.Wait(ctx, o.Wait); err != nil {
+		if creation.Identifier != "" {
+			clone = &VmData{Identifier: creation.Identifier, Hostname: creation.Hostname, DcIdentifier: creation.DcIdentifier}
+		}
+		return clone, true, err
+	}
+
+	if clone.Hostname != hostname {
+		if err := c.Server.ChangeHostName(ctx, clone.Identifier, hostname); err != nil {
+			return clone, false, err
+		}
+		clone.Hostname = hostname
+	}
+	if o.Source == CloneFromBackup {
+		if err := c.Tag.SetServerTags(ctx, clone.Identifier, o.Tags); err != nil {
+			return clone, false, err
+		}
+		clone.Tags = TagList(o.Tags)
+	}
+
+	return clone, false, nil
+}
+
+// serverFirewallGroups returns the identifiers of the firewall groups vm
+// belongs to.
+func (c *Client) serverFirewallGroups(ctx context.Context, vmIdentifier string) ([]string, error) {
+	var groups []string
+	for group, err := range All(ctx, nil, withTotal(c.FirewallGroup.ListWithResponse)) {
+		if err != nil {
+			return nil, err
+		}
+		for _, vm := range group.VmsData {
+			if vm.Identifier == vmIdentifier {
+				groups = append(groups, group.Identifier)
+				break
+			}
+		}
+	}
+
+	return groups, nil
+}
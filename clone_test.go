@@ -0,0 +1,249 @@
+package govpsie_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/vpsieinc/govpsie"
+	"github.com/vpsieinc/govpsie/vpsietest"
+)
+
+var cloneWait = &govpsie.WaitOptions{MinInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+
+func TestCloneServerFromSnapshot(t *testing.T) {
+	srv := vpsietest.NewServer()
+	defer srv.Close()
+
+	source := srv.AddServer(govpsie.VmData{Hostname: "web", DcIdentifier: "dc-1", Cpu: 2, Ram: 2048, Ssd: 40, ProjectID: 7, Tags: govpsie.TagList{"prod"}})
+	srv.AddResourcePlans("dc-1",
+		govpsie.ResourcePlan{Identifier: "small", CPU: 1, RAM: 1024, Ssd: 25, Price: 5},
+		govpsie.ResourcePlan{Identifier: "medium", CPU: 2, RAM: 2048, Ssd: 50, Price: 10},
+	)
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	if err := client.VPC.CreateVpc(ctx, &govpsie.CreateVpcReq{Name: "private", DcIdentifier: "dc-1"}); err != nil {
+		t.Fatal(err)
+	}
+	vpcs, err := client.VPC.List(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.VPC.AssignServer(ctx, &govpsie.AssignServerReq{VmIdentifier: source.Identifier, VpcID: vpcs[0].ID, DcIdentifier: "dc-1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.FirewallGroup.Create(ctx, "web", nil); err != nil {
+		t.Fatal(err)
+	}
+	groups, err := client.FirewallGroup.List(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.FirewallGroup.AssignToVpsie(ctx, groups[0].Identifier, source.Identifier); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.CloneServer(ctx, source.Identifier, &govpsie.CloneOptions{
+		Count:              2,
+		Hostnames:          []string{"web-a"},
+		SameVPC:            true,
+		SameFirewallGroups: true,
+		Wait:               cloneWait,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Servers) != 2 || result.Servers[0].Hostname != "web-a" || result.Servers[1].Hostname != "web-clone-2" {
+		t.Fatalf("servers = %+v", result.Servers)
+	}
+	for _, clone := range result.Servers {
+		details, err := client.Server.GetServerDetails(ctx, clone.Identifier)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if details.VmData.Cpu != 2 || details.VmData.ProjectID != 7 || !reflect.DeepEqual(details.TagNames(), []string{"prod"}) {
+			t.Errorf("clone = %+v", details.VmData)
+		}
+		if len(details.PrivateIpData) != 1 || details.PrivateIpData[0].VpcID != int64(vpcs[0].ID) {
+			t.Errorf("private ips = %+v", details.PrivateIpData)
+		}
+	}
+
+	groups, _ = client.FirewallGroup.List(ctx, nil)
+	if len(groups[0].VmsData) != 3 {
+		t.Errorf("firewall group servers = %+v", groups[0].VmsData)
+	}
+
+	if !result.ArtifactDeleted {
+		t.Error("snapshot not deleted")
+	}
+	if snapshots, _ := client.Snapshot.ListByVm(ctx, nil, source.Identifier); len(snapshots) != 0 {
+		t.Errorf("snapshots left: %+v", snapshots)
+	}
+}
+
+func TestCloneServerFromBackup(t *testing.T) {
+	srv := vpsietest.NewServer()
+	defer srv.Close()
+
+	source := srv.AddServer(govpsie.VmData{Hostname: "db", DcIdentifier: "dc-1", State: "running", Power: 1, Tags: govpsie.TagList{"prod"}})
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	result, err := client.CloneServer(ctx, source.Identifier, &govpsie.CloneOptions{
+		Source:       govpsie.CloneFromBackup,
+		Hostnames:    []string{"db-replica"},
+		Tags:         []string{"replica"},
+		KeepArtifact: true,
+		Wait:         cloneWait,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Servers) != 1 {
+		t.Fatalf("servers = %+v", result.Servers)
+	}
+	clone := result.Servers[0]
+	if clone.Identifier == source.Identifier || clone.Hostname != "db-replica" {
+		t.Errorf("clone = %+v", clone)
+	}
+	if tags, _ := client.Tag.GetServerTags(ctx, clone.Identifier); !reflect.DeepEqual(tags, []string{"replica"}) {
+		t.Errorf("tags = %v", tags)
+	}
+	if backup, err := client.Backup.Get(ctx, result.ArtifactIdentifier); err != nil || result.ArtifactDeleted {
+		t.Errorf("backup not kept: %+v, %v", backup, err)
+	}
+
+	if _, err := client.CloneServer(ctx, source.Identifier, &govpsie.CloneOptions{Source: govpsie.CloneFromBackup, ProjectID: "9"}); err == nil {
+		t.Error("expected a project change from a backup to be rejected")
+	}
+}
+
+func TestCloneServerReturnsPartialClone(t *testing.T) {
+	srv := vpsietest.NewServer()
+	defer srv.Close()
+
+	source := srv.AddServer(govpsie.VmData{Hostname: "db", DcIdentifier: "dc-1", State: "running", Power: 1})
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	// The backup is restored as "db", then renaming it fails.
+	result, err := client.CloneServer(ctx, source.Identifier, &govpsie.CloneOptions{
+		Source:    govpsie.CloneFromBackup,
+		Hostnames: []string{"db replica"},
+		Wait:      cloneWait,
+	})
+	if !errors.Is(err, govpsie.ErrBadRequest) {
+		t.Fatalf("err = %v, want ErrBadRequest", err)
+	}
+
+	if result == nil || len(result.Servers) != 1 {
+		t.Fatalf("result = %+v, want the restored server", result)
+	}
+	clone := result.Servers[0]
+	if clone.Identifier == "" || clone.Identifier == source.Identifier || clone.Hostname != "db" {
+		t.Errorf("clone = %+v", clone)
+	}
+	if _, err := client.Server.GetServerByIdentifier(ctx, clone.Identifier); err != nil {
+		t.Errorf("clone not found: %v", err)
+	}
+	if !result.ArtifactDeleted {
+		t.Error("backup not removed after the failure")
+	}
+}
+
+// rewrite returns a middleware calling edit with every decoded response.
+func rewrite(edit func(v interface{})) govpsie.Middleware {
+	return func(next govpsie.Handler) govpsie.Handler {
+		return func(req *http.Request, v interface{}) (*govpsie.Response, error) {
+			res, err := next(req, v)
+			if err == nil {
+				edit(v)
+			}
+			return res, err
+		}
+	}
+}
+
+func TestCloneServerRemovesFailedArtifact(t *testing.T) {
+	srv := vpsietest.NewServer()
+	defer srv.Close()
+
+	source := srv.AddServer(govpsie.VmData{Hostname: "web", DcIdentifier: "dc-1"})
+
+	client, err := srv.NewClient(govpsie.WithMiddleware(rewrite(func(v interface{}) {
+		// ListByVm decodes into a **ListSnapshotsRoot.
+		if root, ok := v.(**govpsie.ListSnapshotsRoot); ok {
+			for i := range (*root).Data {
+				(*root).Data[i].State = "Failed"
+			}
+		}
+	})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	result, err := client.CloneServer(ctx, source.Identifier, &govpsie.CloneOptions{ResourceIdentifier: "plan", Wait: cloneWait})
+	if err == nil {
+		t.Fatal("expected the failed snapshot to fail the clone")
+	}
+	if result == nil || result.ArtifactIdentifier == "" || !result.ArtifactDeleted {
+		t.Fatalf("result = %+v, want the removed snapshot", result)
+	}
+	if snapshots, _ := client.Snapshot.List(ctx, nil); len(snapshots) != 0 {
+		t.Errorf("snapshots left: %+v", snapshots)
+	}
+}
+
+func TestCloneServerKeepsArtifactOfUnreadyClone(t *testing.T) {
+	srv := vpsietest.NewServer()
+	defer srv.Close()
+
+	source := srv.AddServer(govpsie.VmData{Hostname: "web", DcIdentifier: "dc-1"})
+
+	// The copy never starts.
+	client, err := srv.NewClient(govpsie.WithMiddleware(rewrite(func(v interface{}) {
+		if root, ok := v.(*govpsie.GetStatusRoot); ok {
+			root.Status.Status = "stopped"
+		}
+	})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	wait := *cloneWait
+	wait.Timeout = 50 * time.Millisecond
+	result, err := client.CloneServer(ctx, source.Identifier, &govpsie.CloneOptions{ResourceIdentifier: "plan", Wait: &wait})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want a timeout", err)
+	}
+	if result == nil || len(result.Servers) != 1 || result.Servers[0].Identifier == "" {
+		t.Fatalf("result = %+v, want the requested copy", result)
+	}
+	if result.ArtifactDeleted {
+		t.Error("snapshot removed while the copy is built from it")
+	}
+	if snapshots, _ := client.Snapshot.List(ctx, nil); len(snapshots) != 1 || snapshots[0].Identifier != result.ArtifactIdentifier {
+		t.Errorf("snapshots = %+v, want %s", snapshots, result.ArtifactIdentifier)
+	}
+}
@@ -0,0 +1,355 @@
+// Package cloudinit builds the user data passed to cloud-init through
+// CreateServerRequest.UserData.
+//
+// A Config is a typed cloud-config document, validated when rendered so
+// mistakes surface before the server is created rather than silently at
+// boot:
+//
+//	cfg := cloudinit.New().
+//		AddUser(cloudinit.User{Name: "deploy", Sudo: cloudinit.SudoNoPasswd, SSHAuthorizedKeys: keys}).
+//		AddPackages("nginx").
+//		RunCmd(cloudinit.Exec("systemctl", "enable", "--now", "nginx"))
+//
+//	err := cloudinit.Apply(req, nil, cfg.Part(), cloudinit.ShellScript("setup.sh", script))
+//
+// Several parts are combined into a multipart MIME archive. User data above
+// the size limit is rejected with ErrTooLarge rather than sent in a form
+// cloud-init would not read.
+package cloudinit
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Header is the first line of a cloud-config document.
+const Header = "#cloud-config"
+
+// SudoNoPasswd grants passwordless sudo to a user.
+const SudoNoPasswd = "ALL=(ALL) NOPASSWD:ALL"
+
+// Config is a cloud-config document. Use New and its builder methods, or
+// fill the fields directly.
+type Config struct {
+	Hostname string
+
+	// KeepDefaultUser keeps the image default user when Users are added,
+	// cloud-init otherwise only creates the listed users.
+	KeepDefaultUser bool
+	Users           []User
+
+	// SSHAuthorizedKeys are added to the default user.
+	SSHAuthorizedKeys []string
+
+	PackageUpdate  bool
+	PackageUpgrade bool
+	Packages       []string
+
+	WriteFiles []File
+
+	// BootCmd runs early on every boot, RunCmd once on first boot.
+	BootCmd []Command
+	RunCmd  []Command
+}
+
+// User is a user created by cloud-init.
+type User struct {
+	Name              string
+	Gecos             string
+	Groups            []string
+	Shell             string
+	Sudo              string
+	SSHAuthorizedKeys []string
+
+	// HashedPassword is a crypt(3) hash, as produced by mkpasswd.
+	HashedPassword string
+
+	// LockPassword disables password logins when true, the cloud-init
+	// default.
+	LockPassword *bool
+}
+
+// File is a file written by cloud-init.
+type File struct {
+	Path        string
+	Content     string
+	Owner       string
+	Permissions string
+
+	// Append appends Content to an existing file.
+	Append bool
+
+	// Defer writes the file after users and packages are set up, so Owner
+	// may be a user created by the same config.
+	Defer bool
+}
+
+// Command is a runcmd or bootcmd entry, a shell line or an argument list
+// run without a shell.
+type Command struct {
+	Shell string
+	Argv  []string
+}
+
+// Shell returns a command run by sh.
+func Shell(line string) Command {
+	return Command{Shell: line}
+}
+
+// Exec returns a command run without a shell.
+func Exec(argv ...string) Command {
+	return Command{Argv: argv}
+}
+
+// MarshalYAML renders the command as a string or a list.
+func (c Command) MarshalYAML() (interface{}, error) {
+	if len(c.Argv) > 0 {
+		return c.Argv, nil
+	}
+	return c.Shell, nil
+}
+
+// New returns an empty Config.
+func New() *Config {
+	return &Config{}
+}
+
+// SetHostname sets the hostname.
+func (c *Config) SetHostname(hostname string) *Config {
+	c.Hostname = hostname
+	return c
+}
+
+// AddUser adds a user.
+func (c *Config) AddUser(u User) *Config {
+	c.Users = append(c.Users, u)
+	return c
+}
+
+// AddSSHKeys authorizes keys for the default user.
+func (c *Config) AddSSHKeys(keys ...string) *Config {
+	c.SSHAuthorizedKeys = append(c.SSHAuthorizedKeys, keys...)
+	return c
+}
+
+// AddPackages installs packages, refreshing the package index first.
+func (c *Config) AddPackages(packages ...string) *Config {
+	c.PackageUpdate = true
+	c.Packages = append(c.Packages, packages...)
+	return c
+}
+
+// WriteFile adds a file.
+func (c *Config) WriteFile(f File) *Config {
+	c.WriteFiles = append(c.WriteFiles, f)
+	return c
+}
+
+// AddRunCmd adds commands run on first boot.
+func (c *Config) AddRunCmd(cmds ...Command) *Config {
+	c.RunCmd = append(c.RunCmd, cmds...)
+	return c
+}
+
+// AddBootCmd adds commands run early on every boot.
+func (c *Config) AddBootCmd(cmds ...Command) *Config {
+	c.BootCmd = append(c.BootCmd, cmds...)
+	return c
+}
+
+type document struct {
+	Hostname          string        `yaml:"hostname,omitempty"`
+	Users             []interface{} `yaml:"users,omitempty"`
+	SSHAuthorizedKeys []string      `yaml:"ssh_authorized_keys,omitempty"`
+	PackageUpdate     bool          `yaml:"package_update,omitempty"`
+	PackageUpgrade    bool          `yaml:"package_upgrade,omitempty"`
+	Packages          []string      `yaml:"packages,omitempty"`
+	WriteFiles        []file        `yaml:"write_files,omitempty"`
+	BootCmd           []Command     `yaml:"bootcmd,omitempty"`
+	RunCmd            []Command     `yaml:"runcmd,omitempty"`
+}
+
+type user struct {
+	Name              string   `yaml:"name"`
+	Gecos             string   `yaml:"gecos,omitempty"`
+	Groups            string   `yaml:"groups,omitempty"`
+	Shell             string   `yaml:"shell,omitempty"`
+	Sudo              string   `yaml:"sudo,omitempty"`
+	SSHAuthorizedKeys []string `yaml:"ssh_authorized_keys,omitempty"`
+	HashedPassword    string   `yaml:"hashed_passwd,omitempty"`
+	LockPassword      *bool    `yaml:"lock_passwd,omitempty"`
+}
+
+type file struct {
+	Path        string `yaml:"path"`
+	Content     string `yaml:"content"`
+	Encoding    string `yaml:"encoding,omitempty"`
+	Owner       string `yaml:"owner,omitempty"`
+	Permissions string `yaml:"permissions,omitempty"`
+	Append      bool   `yaml:"append,omitempty"`
+	Defer       bool   `yaml:"defer,omitempty"`
+}
+
+// Validate reports every problem of the config.
+func (c *Config) Validate() error {
+	var errs []error
+	add := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf("cloudinit: "+format, args...))
+	}
+
+	for _, key := range c.SSHAuthorizedKeys {
+		if !validSSHKey(key) {
+			add("invalid ssh key %q", abbreviate(key))
+		}
+	}
+
+	names := make(map[string]bool)
+	for i, u := range c.Users {
+		switch {
+		case u.Name == "":
+			add("user %d has no name", i)
+		case strings.ContainsAny(u.Name, " :,\n") || u.Name == "default":
+			add("invalid user name %q", u.Name)
+		case names[u.Name]:
+			add("duplicate user %q", u.Name)
+		}
+		names[u.Name] = true
+
+		for _, key := range u.SSHAuthorizedKeys {
+			if !validSSHKey(key) {
+				add("user %q: invalid ssh key %q", u.Name, abbreviate(key))
+			}
+		}
+		if u.HashedPassword != "" && !strings.HasPrefix(u.HashedPassword, "$") {
+			add("user %q: password is not a crypt hash", u.Name)
+		}
+	}
+
+	for _, p := range c.Packages {
+		if p == "" || strings.ContainsAny(p, " \t\n") {
+			add("invalid package %q", p)
+		}
+	}
+
+	paths := make(map[string]bool)
+	for _, f := range c.WriteFiles {
+		switch {
+		case !path.IsAbs(f.Path) || path.Clean(f.Path) != f.Path:
+			add("file path %q is not absolute and clean", f.Path)
+		case paths[f.Path] && !f.Append:
+			add("file %q written twice", f.Path)
+		}
+		paths[f.Path] = true
+
+		if f.Permissions != "" && !validPermissions(f.Permissions) {
+			add("file %q: invalid permissions %q", f.Path, f.Permissions)
+		}
+	}
+
+	for _, cmds := range [][]Command{c.BootCmd, c.RunCmd} {
+		for _, cmd := range cmds {
+			if strings.TrimSpace(cmd.Shell) == "" && len(cmd.Argv) == 0 {
+				add("empty command")
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Render validates the config and renders the cloud-config document.
+func (c *Config) Render() ([]byte, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	doc := document{
+		Hostname:          c.Hostname,
+		SSHAuthorizedKeys: c.SSHAuthorizedKeys,
+		PackageUpdate:     c.PackageUpdate,
+		PackageUpgrade:    c.PackageUpgrade,
+		Packages:          c.Packages,
+		BootCmd:           c.BootCmd,
+		RunCmd:            c.RunCmd,
+	}
+
+	if len(c.Users) > 0 && c.KeepDefaultUser {
+		doc.Users = append(doc.Users, "default")
+	}
+	for _, u := range c.Users {
+		doc.Users = append(doc.Users, user{
+			Name:              u.Name,
+			Gecos:             u.Gecos,
+			Groups:            strings.Join(u.Groups, ", "),
+			Shell:             u.Shell,
+			Sudo:              u.Sudo,
+			SSHAuthorizedKeys: u.SSHAuthorizedKeys,
+			HashedPassword:    u.HashedPassword,
+			LockPassword:      u.LockPassword,
+		})
+	}
+
+	for _, f := range c.WriteFiles {
+		out := file{
+			Path:        f.Path,
+			Content:     f.Content,
+			Owner:       f.Owner,
+			Permissions: f.Permissions,
+			Append:      f.Append,
+			Defer:       f.Defer,
+		}
+		// Binary content does not survive YAML, send it base64 encoded.
+		if !utf8.ValidString(f.Content) {
+			out.Content, out.Encoding = encodeBase64([]byte(f.Content)), "b64"
+		}
+		doc.WriteFiles = append(doc.WriteFiles, out)
+	}
+
+	body, err := yaml.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("cloudinit: %w", err)
+	}
+
+	return append([]byte(Header+"\n"), body...), nil
+}
+
+// Part returns the config as a user data part, rendering errors being
+// reported by Encode.
+func (c *Config) Part() Part {
+	content, err := c.Render()
+	return Part{ContentType: ContentTypeCloudConfig, Filename: "cloud-config.yaml", Content: content, err: err}
+}
+
+func validSSHKey(key string) bool {
+	fields := strings.Fields(key)
+	if len(fields) < 2 {
+		return false
+	}
+
+	typ := fields[0]
+	return strings.HasPrefix(typ, "ssh-") || strings.HasPrefix(typ, "ecdsa-sha2-") || strings.HasPrefix(typ, "sk-")
+}
+
+func validPermissions(p string) bool {
+	if len(p) < 3 || len(p) > 5 {
+		return false
+	}
+	for _, r := range strings.TrimPrefix(p, "0") {
+		if r < '0' || r > '7' {
+			return false
+		}
+	}
+	return true
+}
+
+func abbreviate(s string) string {
+	if len(s) > 24 {
+		return s[:24] + "..."
+	}
+	return s
+}
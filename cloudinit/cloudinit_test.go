@@ -0,0 +1,179 @@
+package cloudinit
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"io"
+	"math/rand"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"testing"
+
+	"github.com/vpsieinc/govpsie"
+	"gopkg.in/yaml.v3"
+)
+
+const key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOnl user@host"
+
+func TestRender(t *testing.T) {
+	cfg := New().
+		SetHostname("web-1").
+		AddUser(User{Name: "deploy", Groups: []string{"sudo", "docker"}, Sudo: SudoNoPasswd, SSHAuthorizedKeys: []string{key}}).
+		AddPackages("nginx").
+		WriteFile(File{Path: "/etc/motd", Content: "hello\nworld\n", Permissions: "0644"}).
+		WriteFile(File{Path: "/opt/blob", Content: "\xff\x00"}).
+		AddRunCmd(Exec("systemctl", "enable", "nginx"), Shell("echo done > /tmp/done"))
+	cfg.KeepDefaultUser = true
+
+	out, err := cfg.Render()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(out), Header+"\n") {
+		t.Fatalf("missing header: %s", out)
+	}
+
+	var doc struct {
+		Hostname      string        `yaml:"hostname"`
+		Users         []interface{} `yaml:"users"`
+		PackageUpdate bool          `yaml:"package_update"`
+		Packages      []string      `yaml:"packages"`
+		WriteFiles    []file        `yaml:"write_files"`
+		RunCmd        []interface{} `yaml:"runcmd"`
+	}
+	if err := yaml.Unmarshal(out, &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	if doc.Hostname != "web-1" || !doc.PackageUpdate || len(doc.Packages) != 1 {
+		t.Errorf("doc = %+v", doc)
+	}
+	if len(doc.Users) != 2 || doc.Users[0] != "default" {
+		t.Fatalf("users = %+v", doc.Users)
+	}
+	if u := doc.Users[1].(map[string]interface{}); u["groups"] != "sudo, docker" || u["sudo"] != SudoNoPasswd {
+		t.Errorf("user = %+v", u)
+	}
+	if f := doc.WriteFiles[0]; f.Content != "hello\nworld\n" || f.Permissions != "0644" || f.Encoding != "" {
+		t.Errorf("file = %+v", f)
+	}
+	if f := doc.WriteFiles[1]; f.Encoding != "b64" || f.Content != base64.StdEncoding.EncodeToString([]byte("\xff\x00")) {
+		t.Errorf("binary file = %+v", f)
+	}
+	if _, ok := doc.RunCmd[0].([]interface{}); !ok || doc.RunCmd[1] != "echo done > /tmp/done" {
+		t.Errorf("runcmd = %+v", doc.RunCmd)
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	cfg := New().
+		AddSSHKeys("not a key").
+		AddUser(User{Name: "a b"}).
+		AddUser(User{Name: "ops", HashedPassword: "secret"}).
+		WriteFile(File{Path: "etc/motd"}).
+		WriteFile(File{Path: "/x", Permissions: "999"}).
+		AddRunCmd(Shell(" "))
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("expected errors")
+	}
+	for _, want := range []string{"invalid ssh key", `invalid user name "a b"`, "not a crypt hash", "not absolute", "invalid permissions", "empty command"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("missing %q in:\n%v", want, err)
+		}
+	}
+	if _, err := cfg.Render(); err == nil {
+		t.Error("Render accepted an invalid config")
+	}
+}
+
+func TestEncodeMultipart(t *testing.T) {
+	req := &govpsie.CreateServerRequest{}
+	script := "#!/bin/sh\necho hi\n"
+	if err := Apply(req, nil, New().AddPackages("curl").Part(), ShellScript("setup.sh", script)); err != nil {
+		t.Fatal(err)
+	}
+
+	header, body, _ := strings.Cut(req.UserData, "\r\n\r\n")
+	mediaType, params, err := mime.ParseMediaType(strings.TrimPrefix(strings.Split(header, "\r\n")[0], "Content-Type: "))
+	if err != nil || mediaType != "multipart/mixed" {
+		t.Fatalf("header %q: %v", header, err)
+	}
+
+	r := multipart.NewReader(strings.NewReader(body), params["boundary"])
+	var types []string
+	var last string
+	for {
+		p, err := r.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := io.ReadAll(p)
+		types = append(types, p.Header.Get("Content-Type"))
+		last = string(b)
+	}
+	if len(types) != 2 || !strings.HasPrefix(types[0], ContentTypeCloudConfig) || !strings.HasPrefix(types[1], ContentTypeShellScript) {
+		t.Errorf("types = %v", types)
+	}
+	if last != script {
+		t.Errorf("script = %q", last)
+	}
+}
+
+func TestEncodeCompressesOnlyWhenAsked(t *testing.T) {
+	content := strings.Repeat("echo compressible\n", 2000)
+	part := ShellScript("big.sh", "#!/bin/sh\n"+content)
+
+	if _, err := Encode(nil, part); !errors.Is(err, ErrTooLarge) {
+		t.Fatalf("err = %v, want ErrTooLarge without Compress", err)
+	}
+
+	out, err := Encode(&Options{Compress: true}, part)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out) > DefaultMaxSize {
+		t.Fatalf("len = %d", len(out))
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := io.ReadAll(zr); string(b) != string(part.Content) {
+		t.Error("round trip mismatch")
+	}
+
+	random := make([]byte, 2*DefaultMaxSize)
+	rand.New(rand.NewSource(1)).Read(random)
+	noise := ShellScript("noise.sh", "#!/bin/sh\n"+base64.StdEncoding.EncodeToString(random))
+	if _, err := Encode(&Options{Compress: true}, noise); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("err = %v, want ErrTooLarge", err)
+	}
+}
+
+func TestValidateUserData(t *testing.T) {
+	if err := Validate("#cloud-config\npackages: [curl]\n", 0); err != nil {
+		t.Errorf("valid: %v", err)
+	}
+	if err := Validate("#cloud-config\npackages:\n  - curl\n - wget\n", 0); err == nil {
+		t.Error("accepted bad indentation")
+	}
+	if err := Validate("#cloud-config\njust text\n", 0); err == nil {
+		t.Error("accepted a scalar document")
+	}
+	if err := Validate(strings.Repeat("x", 20), 10); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("err = %v, want ErrTooLarge", err)
+	}
+}
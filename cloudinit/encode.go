@@ -0,0 +1,242 @@
+package cloudinit
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+
+	"github.com/vpsieinc/govpsie"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultMaxSize is the default limit of encoded user data, the 16 KiB most
+// cloud platforms accept.
+const DefaultMaxSize = 16 << 10
+
+// Content types of user data parts.
+const (
+	ContentTypeCloudConfig = "text/cloud-config"
+	ContentTypeShellScript = "text/x-shellscript"
+	ContentTypeBoothook    = "text/cloud-boothook"
+)
+
+// boundary separates multipart parts. It is fixed so that identical user
+// data encodes identically.
+const boundary = "==govpsie-cloudinit=="
+
+// ErrTooLarge is returned when user data exceeds the size limit.
+var ErrTooLarge = errors.New("cloudinit: user data too large")
+
+// Part is a user data part.
+type Part struct {
+	ContentType string
+	Filename    string
+	Content     []byte
+
+	err error
+}
+
+// ShellScript returns a script run once on first boot, after the
+// cloud-config runcmd.
+func ShellScript(filename, script string) Part {
+	return Part{ContentType: ContentTypeShellScript, Filename: filename, Content: []byte(script)}
+}
+
+// Boothook returns a script run early on every boot.
+func Boothook(filename, script string) Part {
+	return Part{ContentType: ContentTypeBoothook, Filename: filename, Content: []byte(script)}
+}
+
+// Options configures Encode.
+type Options struct {
+	// MaxSize limits the encoded user data, DefaultMaxSize if zero.
+	MaxSize int
+
+	// Compress gzip compresses and base64 encodes the user data, MaxSize
+	// then applying to the encoded form. The user data gives no hint of the
+	// encoding, so only set it when the API is known to decode base64 user
+	// data before handing it to cloud-init.
+	Compress bool
+}
+
+// Encode encodes parts into user data: the part itself when there is a
+// single one, a multipart MIME archive otherwise. User data larger than
+// Options.MaxSize fails with ErrTooLarge.
+func Encode(opts *Options, parts ...Part) (string, error) {
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+	if o.MaxSize <= 0 {
+		o.MaxSize = DefaultMaxSize
+	}
+
+	if len(parts) == 0 {
+		return "", errors.New("cloudinit: no parts")
+	}
+	for _, p := range parts {
+		if p.err != nil {
+			return "", p.err
+		}
+		if err := validatePart(p); err != nil {
+			return "", err
+		}
+	}
+
+	data := parts[0].Content
+	if len(parts) > 1 {
+		var err error
+		if data, err = multipartArchive(parts); err != nil {
+			return "", err
+		}
+	}
+
+	if !o.Compress {
+		if len(data) > o.MaxSize {
+			return "", fmt.Errorf("%w: %d bytes, limit %d", ErrTooLarge, len(data), o.MaxSize)
+		}
+		return string(data), nil
+	}
+
+	encoded, err := compress(data)
+	if err != nil {
+		return "", err
+	}
+	if len(encoded) > o.MaxSize {
+		return "", fmt.Errorf("%w: %d bytes compressed, limit %d", ErrTooLarge, len(encoded), o.MaxSize)
+	}
+
+	return encoded, nil
+}
+
+// Apply encodes parts and sets them as the user data of req.
+func Apply(req *govpsie.CreateServerRequest, opts *Options, parts ...Part) error {
+	userData, err := Encode(opts, parts...)
+	if err != nil {
+		return err
+	}
+
+	req.UserData = userData
+	return nil
+}
+
+// Validate checks user data, typically hand written, before it is sent:
+// its size, and that a cloud-config document is a valid YAML mapping.
+// Multipart and compressed user data is only checked for size.
+func Validate(userData string, maxSize int) error {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxSize
+	}
+	if len(userData) > maxSize {
+		return fmt.Errorf("%w: %d bytes, limit %d", ErrTooLarge, len(userData), maxSize)
+	}
+	if userData == "" {
+		return nil
+	}
+
+	return validatePart(Part{ContentType: detectContentType(userData), Content: []byte(userData)})
+}
+
+func validatePart(p Part) error {
+	switch p.ContentType {
+	case ContentTypeCloudConfig:
+		if !bytes.HasPrefix(p.Content, []byte(Header)) {
+			return fmt.Errorf("cloudinit: cloud-config must start with %q", Header)
+		}
+
+		var doc map[string]interface{}
+		if err := yaml.Unmarshal(p.Content, &doc); err != nil {
+			return fmt.Errorf("cloudinit: invalid cloud-config: %w", err)
+		}
+		if doc == nil {
+			return errors.New("cloudinit: cloud-config is not a mapping")
+		}
+
+	case ContentTypeShellScript, ContentTypeBoothook:
+		if !bytes.HasPrefix(p.Content, []byte("#!")) && p.ContentType == ContentTypeShellScript {
+			return fmt.Errorf("cloudinit: script %q has no #! line", p.Filename)
+		}
+
+	case "":
+		return errors.New("cloudinit: part without content type")
+	}
+
+	return nil
+}
+
+func detectContentType(userData string) string {
+	switch {
+	case strings.HasPrefix(userData, Header):
+		return ContentTypeCloudConfig
+	case strings.HasPrefix(userData, "#!"):
+		return ContentTypeShellScript
+	}
+	return "application/octet-stream"
+}
+
+func multipartArchive(parts []Part) ([]byte, error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	for _, p := range parts {
+		if bytes.Contains(p.Content, []byte(boundary)) {
+			return nil, fmt.Errorf("cloudinit: part %q contains the MIME boundary", p.Filename)
+		}
+	}
+	if err := w.SetBoundary(boundary); err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%q\r\nMIME-Version: 1.0\r\n\r\n", boundary)
+	for i, p := range parts {
+		filename := p.Filename
+		if filename == "" {
+			filename = fmt.Sprintf("part-%03d", i+1)
+		}
+
+		h := textproto.MIMEHeader{}
+		h.Set("Content-Type", p.ContentType+`; charset="utf-8"`)
+		h.Set("MIME-Version", "1.0")
+		h.Set("Content-Transfer-Encoding", "8bit")
+		h.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+
+		pw, err := w.CreatePart(h)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := pw.Write(p.Content); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// compress gzips data, which cloud-init detects and decompresses, and
+// base64 encodes it for the JSON request.
+func compress(data []byte) (string, error) {
+	var buf bytes.Buffer
+	zw, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err := zw.Write(data); err != nil {
+		return "", err
+	}
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+
+	return encodeBase64(buf.Bytes()), nil
+}
+
+func encodeBase64(b []byte) string {
+	return base64.StdEncoding.EncodeToString(b)
+}
@@ -0,0 +1,158 @@
+package govpsie
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables read by FromEnv.
+const (
+	EnvAccessToken  = "VPSIE_ACCESS_TOKEN"
+	EnvAPIURL       = "VPSIE_API_URL"
+	EnvClientID     = "VPSIE_CLIENT_ID"
+	EnvClientSecret = "VPSIE_CLIENT_SECRET"
+	EnvProfile      = "VPSIE_PROFILE"
+	EnvConfigFile   = "VPSIE_CONFIG"
+)
+
+// defaultProfile is used when neither the caller, VPSIE_PROFILE nor the
+// config file name a profile.
+const defaultProfile = "default"
+
+// ConfigFile is the layout of ~/.config/vpsie/config.yaml:
+//
+//	current_profile: prod
+//	profiles:
+//	  prod:
+//	    access_token: ...
+//	  staging:
+//	    api_url: https://staging.example.com/apps/v2
+//	    client_id: ...
+//	    client_secret: ...
+type ConfigFile struct {
+	CurrentProfile string                   `yaml:"current_profile"`
+	Profiles       map[string]ConfigProfile `yaml:"profiles"`
+}
+
+// ConfigProfile holds the settings of one named configuration.
+type ConfigProfile struct {
+	APIURL       string            `yaml:"api_url"`
+	AccessToken  string            `yaml:"access_token"`
+	ClientID     string            `yaml:"client_id"`
+	ClientSecret string            `yaml:"client_secret"`
+	Headers      map[string]string `yaml:"headers"`
+}
+
+// DefaultConfigPath returns the path of the config file: $VPSIE_CONFIG if
+// set, else vpsie/config.yaml under $XDG_CONFIG_HOME or ~/.config.
+func DefaultConfigPath() (string, error) {
+	if path := os.Getenv(EnvConfigFile); path != "" {
+		return path, nil
+	}
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "vpsie", "config.yaml"), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, ".config", "vpsie", "config.yaml"), nil
+}
+
+// LoadConfigFile reads and parses the config file at path.
+func LoadConfigFile(path string) (*ConfigFile, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := new(ConfigFile)
+	if err := yaml.Unmarshal(b, cfg); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// FromEnv configures the client from VPSIE_ACCESS_TOKEN, VPSIE_API_URL and
+// VPSIE_CLIENT_ID/VPSIE_CLIENT_SECRET. Unset variables leave the settings
+// of earlier options untouched, so FromEnv can override FromConfigFile.
+func FromEnv() Option {
+	return func(o *clientOptions) error {
+		return ConfigProfile{
+			APIURL:       os.Getenv(EnvAPIURL),
+			AccessToken:  os.Getenv(EnvAccessToken),
+			ClientID:     os.Getenv(EnvClientID),
+			ClientSecret: os.Getenv(EnvClientSecret),
+		}.apply(o)
+	}
+}
+
+// FromConfigFile configures the client from a profile of the config file at
+// path. An empty path means DefaultConfigPath, in which case a missing file
+// is not an error. An empty profile means $VPSIE_PROFILE, the file's
+// current_profile, or "default", in that order.
+func FromConfigFile(path, profile string) Option {
+	return func(o *clientOptions) error {
+		optional := path == ""
+		if optional {
+			var err error
+			if path, err = DefaultConfigPath(); err != nil {
+				return err
+			}
+		}
+
+		cfg, err := LoadConfigFile(path)
+		if optional && errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if profile == "" {
+			profile = os.Getenv(EnvProfile)
+		}
+		if profile == "" {
+			profile = cfg.CurrentProfile
+		}
+		if profile == "" {
+			profile = defaultProfile
+		}
+
+		p, ok := cfg.Profiles[profile]
+		if !ok {
+			return fmt.Errorf("profile %q not found in %s", profile, path)
+		}
+
+		return p.apply(o)
+	}
+}
+
+// apply sets the non-empty settings of p.
+func (p ConfigProfile) apply(o *clientOptions) error {
+	if p.APIURL != "" {
+		if err := WithBaseURL(p.APIURL)(o); err != nil {
+			return err
+		}
+	}
+	if p.AccessToken != "" {
+		if err := WithToken(p.AccessToken)(o); err != nil {
+			return err
+		}
+	}
+	if p.ClientID != "" || p.ClientSecret != "" {
+		if err := WithCredentials(p.ClientID, p.ClientSecret)(o); err != nil {
+			return err
+		}
+	}
+
+	return WithHeaders(p.Headers)(o)
+}
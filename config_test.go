@@ -0,0 +1,45 @@
+package govpsie
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewFromConfigFileAndEnv(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	err := os.WriteFile(path, []byte(`
+current_profile: prod
+profiles:
+  prod:
+    api_url: https://prod.example.com/apps/v2
+    access_token: file-token
+  staging:
+    api_url: https://staging.example.com/apps/v2
+`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(EnvProfile, "")
+	t.Setenv(EnvAPIURL, "")
+	t.Setenv(EnvAccessToken, "env-token")
+
+	client, err := New(FromConfigFile(path, ""), FromEnv(), WithUserAgentSuffix("ci/1.0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := client.BaseURL.String(); got != "https://prod.example.com/apps/v2" {
+		t.Errorf("got base URL %q", got)
+	}
+	if got := client.headers[authHeader]; got != "env-token" {
+		t.Errorf("got token %q, want the one from the environment", got)
+	}
+	if got := client.UserAgent; got != userAgent+" ci/1.0" {
+		t.Errorf("got user agent %q", got)
+	}
+
+	if _, err := New(FromConfigFile(path, "missing")); err == nil {
+		t.Error("expected an error for an unknown profile")
+	}
+}
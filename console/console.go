@@ -0,0 +1,165 @@
+// Package console turns the tickets returned by ServerService.GetServerConsole
+// into VNC sessions.
+//
+// A Dialer opens the websocket of a server console and exposes it as a
+// net.Conn carrying the raw RFB (VNC) stream, whose VNC password is the
+// console Ticket:
+//
+//	d := &console.Dialer{Host: "node1.vpsie.com:8006"}
+//	conn, err := d.DialServer(ctx, client, vmIdentifier)
+//
+// A Proxy is an http.Handler that browsers running noVNC connect to. It
+// fetches a fresh ticket for every connection, authenticates to the console
+// with it and relays the session, offering the browser no VNC security so
+// that the ticket never leaves the proxy:
+//
+//	mux.Handle("/console/{id}", requireAdmin(&console.Proxy{Client: client, Dialer: d}))
+//
+// The proxy gives access to any console the client's account can open, so it
+// must only be mounted behind the portal's own authentication.
+//
+// The package is a module of its own, so that programs which do not use it
+// do not depend on a websocket library. Its go.work builds it against the
+// govpsie checkout next to it rather than the release it requires.
+package console
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/coder/websocket"
+	"github.com/vpsieinc/govpsie"
+)
+
+// maxMessageSize bounds the websocket messages read, VNC framebuffer updates
+// being larger than the websocket default.
+const maxMessageSize = 16 << 20
+
+// Dialer opens console websockets.
+type Dialer struct {
+	// Host is the host[:port] of the console endpoint, used by the default
+	// URL.
+	Host string
+
+	// URL returns the websocket URL of a console. Defaults to ProxmoxURL on
+	// Host.
+	URL func(c *govpsie.ServerConsole) (string, error)
+
+	// TLSConfig is used for wss URLs. By default the system roots are
+	// trusted together with the console Cert.
+	TLSConfig *tls.Config
+}
+
+// ProxmoxURL returns the vncwebsocket URL of a console on host. The node and
+// VM id are taken from the Upid of the console task.
+func ProxmoxURL(host string, c *govpsie.ServerConsole) (string, error) {
+	// UPID:node:pid:pstart:starttime:type:id:user:
+	parts := strings.Split(c.Upid, ":")
+	if len(parts) < 7 || parts[0] != "UPID" || parts[1] == "" || parts[6] == "" {
+		return "", fmt.Errorf("console: unexpected upid %q", c.Upid)
+	}
+	if host == "" {
+		return "", errors.New("console: no host")
+	}
+
+	u := url.URL{
+		Scheme: "wss",
+		Host:   host,
+		Path:   fmt.Sprintf("/api2/json/nodes/%s/qemu/%s/vncwebsocket", parts[1], parts[6]),
+		RawQuery: url.Values{
+			"port":      {c.Port},
+			"vncticket": {c.Ticket},
+		}.Encode(),
+	}
+
+	return u.String(), nil
+}
+
+// Dial opens the console and returns its RFB stream. The stream outlives
+// ctx, which only bounds the handshake.
+func (d *Dialer) Dial(ctx context.Context, c *govpsie.ServerConsole) (net.Conn, error) {
+	ws, err := d.dial(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return websocket.NetConn(context.WithoutCancel(ctx), ws, websocket.MessageBinary), nil
+}
+
+// DialServer fetches a console ticket for the server and dials it.
+func (d *Dialer) DialServer(ctx context.Context, client *govpsie.Client, vmIdentifier string) (net.Conn, error) {
+	c, err := client.Server.GetServerConsole(ctx, vmIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.Dial(ctx, c)
+}
+
+func (d *Dialer) dial(ctx context.Context, c *govpsie.ServerConsole) (*websocket.Conn, error) {
+	var (
+		target string
+		err    error
+	)
+	if d.URL != nil {
+		target, err = d.URL(c)
+	} else {
+		target, err = ProxmoxURL(d.Host, c)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := d.tlsConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make(http.Header)
+	if c.Token != "" {
+		header.Set("Cookie", (&http.Cookie{Name: "PVEAuthCookie", Value: c.Token}).String())
+	}
+
+	ws, _, err := websocket.Dial(ctx, target, &websocket.DialOptions{
+		HTTPClient: &http.Client{
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: tlsConfig,
+			},
+		},
+		HTTPHeader:   header,
+		Subprotocols: []string{"binary"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("console: dial: %w", err)
+	}
+	ws.SetReadLimit(maxMessageSize)
+
+	return ws, nil
+}
+
+func (d *Dialer) tlsConfig(c *govpsie.ServerConsole) (*tls.Config, error) {
+	if d.TLSConfig != nil {
+		return d.TLSConfig, nil
+	}
+	if c.Cert == "" {
+		return nil, nil
+	}
+
+	roots, err := x509.SystemCertPool()
+	if err != nil {
+		roots = x509.NewCertPool()
+	}
+	if !roots.AppendCertsFromPEM([]byte(c.Cert)) {
+		return nil, errors.New("console: invalid certificate")
+	}
+
+	return &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12}, nil
+}
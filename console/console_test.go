@@ -0,0 +1,229 @@
+package console_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/des"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/vpsieinc/govpsie"
+	"github.com/vpsieinc/govpsie/console"
+	"github.com/vpsieinc/govpsie/vpsietest"
+)
+
+// newEchoConsole starts a websocket server echoing binary messages and
+// returns a Dialer pointed at it, recording the URL dialed.
+func newEchoConsole(t *testing.T, dialed *string) *console.Dialer {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := websocket.Accept(w, r, &websocket.AcceptOptions{Subprotocols: []string{"binary"}})
+		if err != nil {
+			return
+		}
+		defer ws.CloseNow()
+
+		for {
+			typ, msg, err := ws.Read(r.Context())
+			if err != nil {
+				return
+			}
+			if err := ws.Write(r.Context(), typ, msg); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return &console.Dialer{
+		Host: "node1.example.com:8006",
+		URL: func(c *govpsie.ServerConsole) (string, error) {
+			u, err := console.ProxmoxURL("node1.example.com:8006", c)
+			*dialed = u
+			return "ws" + strings.TrimPrefix(srv.URL, "http") + "/", err
+		},
+	}
+}
+
+func TestDialServer(t *testing.T) {
+	fake := vpsietest.NewServer()
+	defer fake.Close()
+	vm := fake.AddServer(govpsie.VmData{ID: 101, Hostname: "web-1"})
+
+	client, err := fake.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var dialed string
+	d := newEchoConsole(t, &dialed)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := d.DialServer(ctx, client, vm.Identifier)
+	if err != nil {
+		t.Fatalf("DialServer: %v", err)
+	}
+	defer conn.Close()
+
+	if !strings.HasPrefix(dialed, "wss://node1.example.com:8006/api2/json/nodes/vpsietest/qemu/101/vncwebsocket?port=5900&vncticket=PVEVNC") {
+		t.Errorf("dialed %s", dialed)
+	}
+
+	if _, err := conn.Write([]byte("RFB 003.008\n")); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 12)
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != "RFB 003.008\n" {
+		t.Errorf("read %q", buf)
+	}
+}
+
+// newVNCConsole starts a websocket server requiring VNC authentication with
+// the password passed in its ticket query parameter, then echoing binary
+// messages, and returns a Dialer pointed at it. password returns the query
+// parameter from the console.
+func newVNCConsole(t *testing.T, password func(c *govpsie.ServerConsole) string) *console.Dialer {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := websocket.Accept(w, r, &websocket.AcceptOptions{Subprotocols: []string{"binary"}})
+		if err != nil {
+			return
+		}
+		conn := websocket.NetConn(r.Context(), ws, websocket.MessageBinary)
+		defer conn.Close()
+
+		io.WriteString(conn, "RFB 003.008\n")
+		version := make([]byte, 12)
+		if _, err := io.ReadFull(conn, version); err != nil {
+			return
+		}
+		conn.Write([]byte{2, 1, 2})
+		security := make([]byte, 1)
+		if _, err := io.ReadFull(conn, security); err != nil || security[0] != 2 {
+			t.Errorf("security type %v, err %v", security, err)
+			return
+		}
+
+		challenge := []byte("0123456789abcdef")
+		conn.Write(challenge)
+		response := make([]byte, 16)
+		if _, err := io.ReadFull(conn, response); err != nil {
+			return
+		}
+		if !bytes.Equal(response, vncEncrypt(r.URL.Query().Get("ticket"), challenge)) {
+			conn.Write([]byte{0, 0, 0, 1, 0, 0, 0, 6})
+			io.WriteString(conn, "denied")
+			return
+		}
+		conn.Write([]byte{0, 0, 0, 0})
+
+		io.Copy(conn, conn)
+	}))
+	t.Cleanup(srv.Close)
+
+	return &console.Dialer{
+		URL: func(c *govpsie.ServerConsole) (string, error) {
+			return "ws" + strings.TrimPrefix(srv.URL, "http") + "/?ticket=" + url.QueryEscape(password(c)), nil
+		},
+	}
+}
+
+// vncEncrypt computes the VNC authentication response to challenge.
+func vncEncrypt(password string, challenge []byte) []byte {
+	key := make([]byte, 8)
+	copy(key, password)
+	for i := range key {
+		// VNC uses the bits of each key byte in reverse order.
+		var b byte
+		for j := 0; j < 8; j++ {
+			b |= (key[i] >> j & 1) << (7 - j)
+		}
+		key[i] = b
+	}
+
+	block, err := des.NewCipher(key)
+	if err != nil {
+		panic(err)
+	}
+	out := make([]byte, len(challenge))
+	block.Encrypt(out, challenge[:8])
+	block.Encrypt(out[8:], challenge[8:])
+
+	return out
+}
+
+func TestProxy(t *testing.T) {
+	fake := vpsietest.NewServer()
+	defer fake.Close()
+	vm := fake.AddServer(govpsie.VmData{Hostname: "web-1"})
+
+	client, err := fake.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ticket := func(c *govpsie.ServerConsole) string { return c.Ticket }
+	mux := http.NewServeMux()
+	mux.Handle("/console/{id}", &console.Proxy{Client: client, Dialer: newVNCConsole(t, ticket)})
+	mux.Handle("/denied/{id}", &console.Proxy{Client: client, Dialer: newVNCConsole(t, func(*govpsie.ServerConsole) string { return "other" })})
+	proxy := httptest.NewServer(mux)
+	defer proxy.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	ws, _, err := websocket.Dial(ctx, "ws"+strings.TrimPrefix(proxy.URL, "http")+"/console/"+vm.Identifier,
+		&websocket.DialOptions{Subprotocols: []string{"binary"}})
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	conn := websocket.NetConn(ctx, ws, websocket.MessageBinary)
+	defer conn.Close()
+
+	// noVNC is offered no security, the proxy having authenticated.
+	expect := func(want string) {
+		t.Helper()
+		got := make([]byte, len(want))
+		if _, err := io.ReadFull(conn, got); err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != want {
+			t.Fatalf("read %q, want %q", got, want)
+		}
+	}
+	expect("RFB 003.008\n")
+	io.WriteString(conn, "RFB 003.008\n")
+	expect("\x01\x01")
+	conn.Write([]byte{1})
+	expect("\x00\x00\x00\x00")
+
+	conn.Write([]byte{1, 2, 3})
+	expect("\x01\x02\x03")
+
+	for path, want := range map[string]int{
+		"/console/unknown":         http.StatusNotFound,
+		"/denied/" + vm.Identifier: http.StatusBadGateway,
+	} {
+		res, err := http.Get(proxy.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		if res.StatusCode != want {
+			t.Errorf("GET %s status = %d, want %d", path, res.StatusCode, want)
+		}
+	}
+}
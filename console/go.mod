@@ -0,0 +1,14 @@
+module github.com/vpsieinc/govpsie/console
+
+go 1.25.0
+
+require (
+	github.com/coder/websocket v1.8.14
+	github.com/vpsieinc/govpsie v0.1.0
+)
+
+require (
+	github.com/kr/text v0.2.0 // indirect
+	golang.org/x/oauth2 v0.27.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
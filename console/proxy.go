@@ -0,0 +1,128 @@
+package console
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+
+	"github.com/coder/websocket"
+	"github.com/vpsieinc/govpsie"
+)
+
+// Proxy relays browser websockets, e.g. from noVNC, to server consoles. It
+// authenticates to the console with its ticket, so the browser connects
+// without a password.
+type Proxy struct {
+	Client *govpsie.Client
+	Dialer *Dialer
+
+	// Identifier returns the server whose console is requested. Defaults to
+	// the {id} path value, then the vm query parameter.
+	Identifier func(r *http.Request) string
+
+	// OriginPatterns lists the origins, besides the proxy's own, allowed to
+	// open consoles. See websocket.AcceptOptions.
+	OriginPatterns []string
+
+	// Logger receives relay failures, slog.Default() if nil.
+	Logger *slog.Logger
+}
+
+var _ http.Handler = &Proxy{}
+
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id := p.identifier(r)
+	if id == "" {
+		http.Error(w, "missing server identifier", http.StatusBadRequest)
+		return
+	}
+
+	c, err := p.Client.Server.GetServerConsole(r.Context(), id)
+	if err != nil {
+		status := http.StatusBadGateway
+		if errors.Is(err, govpsie.ErrNotFound) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, "console unavailable", status)
+		p.logger().ErrorContext(r.Context(), "console: get ticket", "vm", id, "error", err)
+		return
+	}
+
+	upstream, err := p.Dialer.Dial(r.Context(), c)
+	if err != nil {
+		http.Error(w, "console unavailable", http.StatusBadGateway)
+		p.logger().ErrorContext(r.Context(), "console: dial", "vm", id, "error", err)
+		return
+	}
+	defer upstream.Close()
+
+	// The ticket stays on this side: the proxy authenticates with it and
+	// the browser is offered a session without a password.
+	if err := authenticate(upstream, c.Ticket); err != nil {
+		http.Error(w, "console unavailable", http.StatusBadGateway)
+		p.logger().ErrorContext(r.Context(), "console: authenticate", "vm", id, "error", err)
+		return
+	}
+
+	ws, err := websocket.Accept(w, r, &websocket.AcceptOptions{
+		Subprotocols:   []string{"binary"},
+		OriginPatterns: p.OriginPatterns,
+	})
+	if err != nil {
+		// Accept has already answered the request.
+		return
+	}
+	ws.SetReadLimit(maxMessageSize)
+	browser := websocket.NetConn(context.WithoutCancel(r.Context()), ws, websocket.MessageBinary)
+	defer browser.Close()
+
+	if err := acceptClient(browser); err != nil {
+		p.logger().WarnContext(r.Context(), "console: handshake", "vm", id, "error", err)
+		return
+	}
+
+	errc := make(chan error, 2)
+	go func() { errc <- relay(browser, upstream) }()
+	go func() { errc <- relay(upstream, browser) }()
+
+	// Closing both ends stops the other relay.
+	if err := <-errc; err != nil {
+		p.logger().WarnContext(r.Context(), "console: relay", "vm", id, "error", err)
+	}
+	browser.Close()
+	upstream.Close()
+	<-errc
+}
+
+func (p *Proxy) identifier(r *http.Request) string {
+	if p.Identifier != nil {
+		return p.Identifier(r)
+	}
+	if id := r.PathValue("id"); id != "" {
+		return id
+	}
+
+	return r.URL.Query().Get("vm")
+}
+
+func (p *Proxy) logger() *slog.Logger {
+	if p.Logger != nil {
+		return p.Logger
+	}
+
+	return slog.Default()
+}
+
+// relay copies src to dst until src ends. A connection closed by either side
+// is not an error.
+func relay(dst, src net.Conn) error {
+	_, err := io.Copy(dst, src)
+	if errors.Is(err, net.ErrClosed) || websocket.CloseStatus(err) != -1 {
+		return nil
+	}
+
+	return err
+}
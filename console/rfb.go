@@ -0,0 +1,163 @@
+package console
+
+import (
+	"crypto/des"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+)
+
+// RFB security types, see RFC 6143 section 7.1.2.
+const (
+	securityInvalid = 0
+	securityNone    = 1
+	securityVNCAuth = 2
+)
+
+const (
+	rfbVersion33 = "RFB 003.003\n"
+	rfbVersion37 = "RFB 003.007\n"
+	rfbVersion38 = "RFB 003.008\n"
+)
+
+// authenticate performs the client side of the RFB handshake on a console,
+// using password for VNC authentication. The stream is then ready for the
+// ClientInit message.
+func authenticate(rw io.ReadWriter, password string) error {
+	version := make([]byte, 12)
+	if _, err := io.ReadFull(rw, version); err != nil {
+		return fmt.Errorf("console: read version: %w", err)
+	}
+	if string(version) < rfbVersion38 {
+		return fmt.Errorf("console: unsupported server version %q", version)
+	}
+	if _, err := io.WriteString(rw, rfbVersion38); err != nil {
+		return err
+	}
+
+	var n [1]byte
+	if _, err := io.ReadFull(rw, n[:]); err != nil {
+		return fmt.Errorf("console: read security types: %w", err)
+	}
+	if n[0] == 0 {
+		return fmt.Errorf("console: connection refused: %w", readReason(rw))
+	}
+	types := make([]byte, n[0])
+	if _, err := io.ReadFull(rw, types); err != nil {
+		return fmt.Errorf("console: read security types: %w", err)
+	}
+
+	security := byte(securityInvalid)
+	for _, t := range types {
+		if t == securityVNCAuth || t == securityNone && security == securityInvalid {
+			security = t
+		}
+	}
+	if security == securityInvalid {
+		return fmt.Errorf("console: unsupported security types %v", types)
+	}
+	if _, err := rw.Write([]byte{security}); err != nil {
+		return err
+	}
+
+	if security == securityVNCAuth {
+		challenge := make([]byte, 16)
+		if _, err := io.ReadFull(rw, challenge); err != nil {
+			return fmt.Errorf("console: read challenge: %w", err)
+		}
+		if _, err := rw.Write(vncAuthResponse(password, challenge)); err != nil {
+			return err
+		}
+	}
+
+	var result [4]byte
+	if _, err := io.ReadFull(rw, result[:]); err != nil {
+		return fmt.Errorf("console: read security result: %w", err)
+	}
+	if binary.BigEndian.Uint32(result[:]) != 0 {
+		return fmt.Errorf("console: authentication failed: %w", readReason(rw))
+	}
+
+	return nil
+}
+
+// acceptClient performs the server side of the RFB handshake with a client
+// that was already authenticated by other means, offering no security.
+func acceptClient(rw io.ReadWriter) error {
+	if _, err := io.WriteString(rw, rfbVersion38); err != nil {
+		return err
+	}
+
+	version := make([]byte, 12)
+	if _, err := io.ReadFull(rw, version); err != nil {
+		return fmt.Errorf("console: read client version: %w", err)
+	}
+
+	switch string(version) {
+	case rfbVersion33:
+		// The server picks the security type.
+		_, err := rw.Write(binary.BigEndian.AppendUint32(nil, securityNone))
+		return err
+	case rfbVersion37, rfbVersion38:
+	default:
+		return fmt.Errorf("console: unsupported client version %q", version)
+	}
+
+	if _, err := rw.Write([]byte{1, securityNone}); err != nil {
+		return err
+	}
+
+	var security [1]byte
+	if _, err := io.ReadFull(rw, security[:]); err != nil {
+		return fmt.Errorf("console: read security type: %w", err)
+	}
+	if security[0] != securityNone {
+		return fmt.Errorf("console: client chose security type %d", security[0])
+	}
+
+	// Version 3.7 has no result for the None type.
+	if string(version) == rfbVersion38 {
+		_, err := rw.Write(binary.BigEndian.AppendUint32(nil, 0))
+		return err
+	}
+
+	return nil
+}
+
+// vncAuthResponse encrypts challenge with password as VNC authentication
+// does: DES keyed with the first eight bytes of the password, each with its
+// bits reversed.
+func vncAuthResponse(password string, challenge []byte) []byte {
+	key := make([]byte, 8)
+	copy(key, password)
+	for i, b := range key {
+		key[i] = bits.Reverse8(b)
+	}
+
+	// The key is always eight bytes long.
+	block, _ := des.NewCipher(key)
+
+	response := make([]byte, len(challenge))
+	for i := 0; i+des.BlockSize <= len(challenge); i += des.BlockSize {
+		block.Encrypt(response[i:], challenge[i:])
+	}
+
+	return response
+}
+
+// readReason reads the reason string following a failure.
+func readReason(r io.Reader) error {
+	var n [4]byte
+	if _, err := io.ReadFull(r, n[:]); err != nil {
+		return errors.New("no reason given")
+	}
+
+	reason := make([]byte, min(binary.BigEndian.Uint32(n[:]), 1024))
+	if _, err := io.ReadFull(r, reason); err != nil {
+		return errors.New("no reason given")
+	}
+
+	return errors.New(string(reason))
+}
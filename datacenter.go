@@ -2,7 +2,6 @@ package govpsie
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 )
 
@@ -10,6 +9,7 @@ var dataCenterBasePath = "/apps/v2/datacenter"
 
 type DataCenterService interface {
 	List(ctx context.Context, options *ListOptions) ([]DataCenter, error)
+	ListWithResponse(ctx context.Context, options *ListOptions) ([]DataCenter, *Response, error)
 }
 
 type dataCenterServiceHandler struct {
@@ -39,18 +39,29 @@ type DataCenterListRoot struct {
 }
 
 func (d *dataCenterServiceHandler) List(ctx context.Context, options *ListOptions) ([]DataCenter, error) {
-	path := fmt.Sprintf("%s?offset=%d&limit%d", dataCenterBasePath, options.Page, options.PerPage)
+	items, _, err := d.ListWithResponse(ctx, options)
+	return items, err
+}
+
+func (d *dataCenterServiceHandler) ListWithResponse(ctx context.Context, options *ListOptions) ([]DataCenter, *Response, error) {
+	ctx = withOperation(ctx, "DataCenterService", "List")
+
+	path, err := addOptions(dataCenterBasePath, options)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil)
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	root := new(DataCenterListRoot)
-	if err = d.client.Do(ctx, req, root); err != nil {
-		return nil, err
+	resp, err := d.client.DoWithResponse(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
 	}
 
-	return root.Data, nil
+	return root.Data, resp, nil
 }
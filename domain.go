@@ -13,6 +13,7 @@ type DomainService interface {
 	ListDomainByProject(ctx context.Context, options *ListOptions, projectIdentifier string) ([]Domain, error)
 	DnsRecord(ctx context.Context, domainIdentifier string, dnsRecord *DnsRecord) error
 	ListDomains(ctx context.Context, options *ListOptions) ([]Domain, error)
+	ListDomainsWithResponse(ctx context.Context, options *ListOptions) ([]Domain, *Response, error)
 	ListAllDomains(ctx context.Context) ([]Domain, error)
 	ListDomainVpsies(ctx context.Context, options *ListOptions) ([]DomainVpsie, error)
 	CreateDomain(ctx context.Context, createReq *CreateDomainRequest) error
@@ -37,15 +38,16 @@ var _ DomainService = &domainsServiceHandler{}
 type ListDomainRoot struct {
 	Error bool     `json:"error"`
 	Data  []Domain `json:"data"`
-	Total int      `json:"total "`
+	Total int      `json:"total"`
 }
 
 type Domain struct {
-	DomainName  string `json:"domain_name"`
-	Identifier  string `json:"identifier"`
-	NsValidated int    `json:"ns_validated"`
-	CreatedOn   string `json:"created_on"`
-	LastCheck   string `json:"last_check"`
+	DomainName  string  `json:"domain_name"`
+	Identifier  string  `json:"identifier"`
+	NsValidated int     `json:"ns_validated"`
+	CreatedOn   string  `json:"created_on"`
+	LastCheck   string  `json:"last_check"`
+	Tags        TagList `json:"tags,omitempty"`
 }
 
 type DnsRecord struct {
@@ -120,7 +122,12 @@ type ListReversePTRRoot struct {
 }
 
 func (d *domainsServiceHandler) ListDomainByProject(ctx context.Context, options *ListOptions, projectIdentifier string) ([]Domain, error) {
-	path := fmt.Sprintf("%s/project/%s?offset=%d&limit%d", domainsPath, projectIdentifier, options.Page, options.PerPage)
+	ctx = withOperation(ctx, "DomainService", "ListDomainByProject")
+
+	path, err := addOptions(fmt.Sprintf("%s/project/%s", domainsPath, projectIdentifier), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -136,6 +143,8 @@ func (d *domainsServiceHandler) ListDomainByProject(ctx context.Context, options
 }
 
 func (d *domainsServiceHandler) CreateDnsRecord(ctx context.Context, createReq CreateDnsRecordReq) error {
+	ctx = withOperation(ctx, "DomainService", "CreateDnsRecord")
+
 	path := fmt.Sprintf("%s/dnsRecord", domainPath)
 
 	req, err := d.client.NewRequest(ctx, http.MethodPost, path, createReq)
@@ -147,6 +156,8 @@ func (d *domainsServiceHandler) CreateDnsRecord(ctx context.Context, createReq C
 }
 
 func (d *domainsServiceHandler) UpdateDnsRecord(ctx context.Context, updateReq *UpdateDnsRecordReq) error {
+	ctx = withOperation(ctx, "DomainService", "UpdateDnsRecord")
+
 	path := fmt.Sprintf("%s/dnsRecord/update", domainPath)
 
 	req, err := d.client.NewRequest(ctx, http.MethodPut, path, updateReq)
@@ -158,6 +169,8 @@ func (d *domainsServiceHandler) UpdateDnsRecord(ctx context.Context, updateReq *
 }
 
 func (d *domainsServiceHandler) DeleteDnsRecord(ctx context.Context, domainIdentifier string, record *Record) error {
+	ctx = withOperation(ctx, "DomainService", "DeleteDnsRecord")
+
 	path := fmt.Sprintf("%s/dnsRecord/delete", domainPath)
 
 	updateReq := struct {
@@ -177,6 +190,8 @@ func (d *domainsServiceHandler) DeleteDnsRecord(ctx context.Context, domainIdent
 }
 
 func (d *domainsServiceHandler) DnsRecord(ctx context.Context, domainIdentifier string, dnsRecord *DnsRecord) error {
+	ctx = withOperation(ctx, "DomainService", "DnsRecord")
+
 	path := fmt.Sprintf("%s/dnsRecord", domainPath)
 
 	dnsRecordReq := struct {
@@ -196,20 +211,33 @@ func (d *domainsServiceHandler) DnsRecord(ctx context.Context, domainIdentifier
 }
 
 func (d *domainsServiceHandler) ListDomains(ctx context.Context, options *ListOptions) ([]Domain, error) {
-	path := fmt.Sprintf("%s?offset=%d&limit=%d", domainsPath, options.Page, options.PerPage)
+	items, _, err := d.ListDomainsWithResponse(ctx, options)
+	return items, err
+}
+
+func (d *domainsServiceHandler) ListDomainsWithResponse(ctx context.Context, options *ListOptions) ([]Domain, *Response, error) {
+	ctx = withOperation(ctx, "DomainService", "ListDomains")
+
+	path, err := addOptions(domainsPath, options)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	domains := new(ListDomainRoot)
-	if err = d.client.Do(ctx, req, &domains); err != nil {
-		return nil, err
+	resp, err := d.client.DoWithResponse(ctx, req, &domains)
+	if err != nil {
+		return nil, resp, err
 	}
-	return domains.Data, nil
+	return domains.Data, resp, nil
 }
 
 func (d *domainsServiceHandler) ListAllDomains(ctx context.Context) ([]Domain, error) {
+	ctx = withOperation(ctx, "DomainService", "ListAllDomains")
+
 	path := fmt.Sprintf("%s", domainsPath)
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -224,7 +252,12 @@ func (d *domainsServiceHandler) ListAllDomains(ctx context.Context) ([]Domain, e
 }
 
 func (d *domainsServiceHandler) ListDomainVpsies(ctx context.Context, options *ListOptions) ([]DomainVpsie, error) {
-	path := fmt.Sprintf("%s/vms?offset=%d&limit=%d", domainsPath, options.Page, options.PerPage)
+	ctx = withOperation(ctx, "DomainService", "ListDomainVpsies")
+
+	path, err := addOptions(fmt.Sprintf("%s/vms", domainsPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -239,6 +272,8 @@ func (d *domainsServiceHandler) ListDomainVpsies(ctx context.Context, options *L
 }
 
 func (d *domainsServiceHandler) CreateDomain(ctx context.Context, createReq *CreateDomainRequest) error {
+	ctx = withOperation(ctx, "DomainService", "CreateDomain")
+
 	path := fmt.Sprintf("%s/add", domainPath)
 
 	req, err := d.client.NewRequest(ctx, http.MethodPost, path, createReq)
@@ -250,6 +285,8 @@ func (d *domainsServiceHandler) CreateDomain(ctx context.Context, createReq *Cre
 }
 
 func (d *domainsServiceHandler) GetDomainByVpsie(ctx context.Context, domainIdentifier string) ([]Domain, error) {
+	ctx = withOperation(ctx, "DomainService", "GetDomainByVpsie")
+
 	path := fmt.Sprintf("%s/vm/%s", domainsPath, domainIdentifier)
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -265,6 +302,8 @@ func (d *domainsServiceHandler) GetDomainByVpsie(ctx context.Context, domainIden
 }
 
 func (d *domainsServiceHandler) DeleteDomain(ctx context.Context, domainIdentifier, reason, note string) error {
+	ctx = withOperation(ctx, "DomainService", "DeleteDomain")
+
 	path := fmt.Sprintf("%s/delete", domainPath)
 
 	deleteReq := struct {
@@ -292,6 +331,8 @@ func (d *domainsServiceHandler) DeleteDomain(ctx context.Context, domainIdentifi
 }
 
 func (d *domainsServiceHandler) AddReverse(ctx context.Context, reverseReq *ReverseRequest) error {
+	ctx = withOperation(ctx, "DomainService", "AddReverse")
+
 	path := fmt.Sprintf("%s/addreverse", domainPath)
 
 	req, err := d.client.NewRequest(ctx, http.MethodPost, path, reverseReq)
@@ -303,6 +344,8 @@ func (d *domainsServiceHandler) AddReverse(ctx context.Context, reverseReq *Reve
 }
 
 func (d *domainsServiceHandler) UpdateReverse(ctx context.Context, reverseReq *ReverseRequest) error {
+	ctx = withOperation(ctx, "DomainService", "UpdateReverse")
+
 	path := fmt.Sprintf("%s/reverse/update", domainPath)
 
 	req, err := d.client.NewRequest(ctx, http.MethodPut, path, reverseReq)
@@ -314,6 +357,8 @@ func (d *domainsServiceHandler) UpdateReverse(ctx context.Context, reverseReq *R
 }
 
 func (d *domainsServiceHandler) UpdateDomain(ctx context.Context, dnsRecord *DnsRecord, domainIdentifier, vmIdentifier string) error {
+	ctx = withOperation(ctx, "DomainService", "UpdateDomain")
+
 	path := fmt.Sprintf("%s/update", domainPath)
 
 	dnsRecordReq := struct {
@@ -335,6 +380,8 @@ func (d *domainsServiceHandler) UpdateDomain(ctx context.Context, dnsRecord *Dns
 }
 
 func (d *domainsServiceHandler) DeleteReverse(ctx context.Context, ip, vmIdentifier string) error {
+	ctx = withOperation(ctx, "DomainService", "DeleteReverse")
+
 	path := fmt.Sprintf("%s/reverse/delete", domainPath)
 
 	rvDeleteReq := struct {
@@ -354,6 +401,8 @@ func (d *domainsServiceHandler) DeleteReverse(ctx context.Context, ip, vmIdentif
 }
 
 func (d *domainsServiceHandler) ListReversePTRRecords(ctx context.Context) ([]ReversePTR, error) {
+	ctx = withOperation(ctx, "DomainService", "ListReversePTRRecords")
+
 	path := fmt.Sprintf("%s/reverse/all", domainPath)
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -0,0 +1,119 @@
+package govpsie
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+// Sentinel errors that an *APIError matches through errors.Is.
+var (
+	ErrBadRequest   = errors.New("vpsie: bad request")
+	ErrUnauthorized = errors.New("vpsie: unauthorized")
+	ErrForbidden    = errors.New("vpsie: forbidden")
+	ErrNotFound     = errors.New("vpsie: not found")
+	ErrConflict     = errors.New("vpsie: conflict")
+	ErrRateLimited  = errors.New("vpsie: rate limited")
+	ErrServer       = errors.New("vpsie: server error")
+)
+
+// maxErrorBodyLen caps how much of a non-JSON error body ends up in Message.
+const maxErrorBodyLen = 256
+
+// APIError is returned by Client.Do whenever the VPSie API reports a failure,
+// either through a non-2xx status or through an {"error":true} body.
+type APIError struct {
+	// HTTP status code of the response.
+	StatusCode int
+
+	// Code, Message and Stack as reported in the ErrorRsp body, if any.
+	Code    int
+	Message string
+	Stack   string
+
+	// Method and URL of the request that failed.
+	Method string
+	URL    string
+
+	// Raw response body.
+	Body []byte
+}
+
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = http.StatusText(e.status())
+	}
+
+	return fmt.Sprintf("%s %s: %d %s", e.Method, e.URL, e.StatusCode, msg)
+}
+
+// Is reports whether the error matches one of the package sentinels. The HTTP
+// status decides, except for errors delivered with HTTP 200 where the API
+// code in the body is used instead.
+func (e *APIError) Is(target error) bool {
+	switch status := e.status(); target {
+	case ErrBadRequest:
+		return status == http.StatusBadRequest
+	case ErrUnauthorized:
+		return status == http.StatusUnauthorized
+	case ErrForbidden:
+		return status == http.StatusForbidden
+	case ErrNotFound:
+		return status == http.StatusNotFound
+	case ErrConflict:
+		return status == http.StatusConflict
+	case ErrRateLimited:
+		return status == http.StatusTooManyRequests
+	case ErrServer:
+		return status >= http.StatusInternalServerError
+	}
+
+	return false
+}
+
+// status returns the status used for sentinel matching.
+func (e *APIError) status() int {
+	if e.StatusCode >= http.StatusOK && e.StatusCode < 300 && e.Code >= 400 && e.Code < 600 {
+		return e.Code
+	}
+
+	return e.StatusCode
+}
+
+func newAPIError(req *http.Request, res *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		Body:       body,
+	}
+
+	var errRsp ErrorRsp
+	if err := json.Unmarshal(body, &errRsp); err == nil {
+		apiErr.Code = errRsp.Code
+		apiErr.Message = errRsp.Message
+		apiErr.Stack = errRsp.Stack
+		return apiErr
+	}
+
+	// Not JSON, e.g. an HTML page from a proxy in front of the API.
+	msg := strings.TrimSpace(string(body))
+	if len(msg) > maxErrorBodyLen {
+		// Cut on a rune boundary.
+		n := maxErrorBodyLen
+		for n > 0 && !utf8.RuneStart(msg[n]) {
+			n--
+		}
+		msg = msg[:n] + "..."
+	}
+	if strings.HasPrefix(msg, "<") {
+		msg = ""
+	}
+	apiErr.Message = msg
+
+	return apiErr
+}
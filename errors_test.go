@@ -0,0 +1,113 @@
+package govpsie
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestDoReturnsAPIError(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		sentinel error
+		code     int
+		message  string
+	}{
+		{
+			name:     "json error body",
+			status:   http.StatusNotFound,
+			body:     `{"error":true,"code":404,"message":"vm not found","stack":"trace"}`,
+			sentinel: ErrNotFound,
+			code:     404,
+			message:  "vm not found",
+		},
+		{
+			name:     "html from proxy",
+			status:   http.StatusBadGateway,
+			body:     `<html><body>502 Bad Gateway</body></html>`,
+			sentinel: ErrServer,
+		},
+		{
+			name:     "error with http 200",
+			status:   http.StatusOK,
+			body:     `{"error":true,"code":409,"message":"hostname taken"}`,
+			sentinel: ErrConflict,
+			code:     409,
+			message:  "hostname taken",
+		},
+		{
+			name:     "rate limited",
+			status:   http.StatusTooManyRequests,
+			body:     `{"error":true,"message":"slow down"}`,
+			sentinel: ErrRateLimited,
+			message:  "slow down",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			client := NewClient(srv.Client())
+			client.SetRetryPolicy(nil)
+			if err := client.SetBaseURL(srv.URL); err != nil {
+				t.Fatal(err)
+			}
+
+			req, err := client.NewRequest(context.Background(), http.MethodGet, "/apps/v2/vm", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = client.Do(context.Background(), req, nil)
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected *APIError, got %T: %v", err, err)
+			}
+			if !errors.Is(err, tt.sentinel) {
+				t.Errorf("expected errors.Is(err, %v)", tt.sentinel)
+			}
+			if apiErr.StatusCode != tt.status {
+				t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, tt.status)
+			}
+			if apiErr.Code != tt.code {
+				t.Errorf("Code = %d, want %d", apiErr.Code, tt.code)
+			}
+			if apiErr.Message != tt.message {
+				t.Errorf("Message = %q, want %q", apiErr.Message, tt.message)
+			}
+			if apiErr.Method != http.MethodGet || apiErr.URL != srv.URL+"/apps/v2/vm" {
+				t.Errorf("unexpected request %s %s", apiErr.Method, apiErr.URL)
+			}
+			if string(apiErr.Body) != tt.body {
+				t.Errorf("Body = %q, want %q", apiErr.Body, tt.body)
+			}
+		})
+	}
+}
+
+func TestNewAPIErrorTruncatesOnRuneBoundary(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/apps/v2/vm", nil)
+	res := &http.Response{StatusCode: http.StatusBadGateway}
+
+	// The 256th byte falls in the middle of a two byte rune.
+	body := "x" + strings.Repeat("é", maxErrorBodyLen)
+	apiErr := newAPIError(req, res, []byte(body))
+	if !utf8.ValidString(apiErr.Message) || !strings.HasSuffix(apiErr.Message, "...") {
+		t.Errorf("Message = %q", apiErr.Message)
+	}
+	if len(apiErr.Message) > maxErrorBodyLen+len("...") {
+		t.Errorf("len(Message) = %d", len(apiErr.Message))
+	}
+}
@@ -0,0 +1,300 @@
+package govpsie
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ServerFilter reports whether a server matches. Filters are built with
+// Where and its helpers, or parsed with ParseServerFilter.
+//
+//	running := govpsie.Where(govpsie.Tag("prod"), govpsie.DC(dc), govpsie.StateIs("running"))
+//	servers = running.Filter(servers)
+type ServerFilter func(vm *VmData) bool
+
+// Filter returns the servers matching f, keeping their order. A nil filter
+// matches every server.
+func (f ServerFilter) Filter(servers []VmData) []VmData {
+	matched := make([]VmData, 0, len(servers))
+	for i := range servers {
+		if f == nil || f(&servers[i]) {
+			matched = append(matched, servers[i])
+		}
+	}
+
+	return matched
+}
+
+// Where matches servers matching every filter.
+func Where(filters ...ServerFilter) ServerFilter {
+	return func(vm *VmData) bool {
+		for _, f := range filters {
+			if !f(vm) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// AnyOf matches servers matching at least one filter.
+func AnyOf(filters ...ServerFilter) ServerFilter {
+	return func(vm *VmData) bool {
+		for _, f := range filters {
+			if f(vm) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not matches servers not matching f.
+func Not(f ServerFilter) ServerFilter {
+	return func(vm *VmData) bool {
+		return !f(vm)
+	}
+}
+
+// Tag matches servers tagged with tag.
+func Tag(tag string) ServerFilter {
+	return func(vm *VmData) bool {
+		return vm.Tags.Has(tag)
+	}
+}
+
+// DC matches servers in the data center.
+func DC(dcIdentifier string) ServerFilter {
+	return func(vm *VmData) bool {
+		return strings.EqualFold(vm.DcIdentifier, dcIdentifier)
+	}
+}
+
+// StateIs matches servers in state, such as running or stopped. Servers
+// without a State are matched on Power.
+func StateIs(state ServerState) ServerFilter {
+	want := ServerState(strings.ToLower(string(state)))
+	return func(vm *VmData) bool {
+		return serverState(vm, nil) == want
+	}
+}
+
+// InProject matches servers of the project.
+func InProject(projectID int64) ServerFilter {
+	return func(vm *VmData) bool {
+		return vm.ProjectID == projectID
+	}
+}
+
+// CPUAtLeast matches servers with at least n CPUs.
+func CPUAtLeast(n int64) ServerFilter {
+	return func(vm *VmData) bool {
+		return vm.Cpu >= n
+	}
+}
+
+// RAMAtLeast matches servers with at least mb of RAM.
+func RAMAtLeast(mb int64) ServerFilter {
+	return func(vm *VmData) bool {
+		return vm.Ram >= mb
+	}
+}
+
+// SSDAtLeast matches servers with at least gb of disk.
+func SSDAtLeast(gb int64) ServerFilter {
+	return func(vm *VmData) bool {
+		return vm.Ssd >= gb
+	}
+}
+
+// HostnameMatches matches servers whose hostname matches the path.Match
+// pattern, ignoring case.
+func HostnameMatches(pattern string) ServerFilter {
+	return func(vm *VmData) bool {
+		return globMatch(pattern, vm.Hostname)
+	}
+}
+
+func globMatch(pattern, s string) bool {
+	ok, _ := path.Match(strings.ToLower(pattern), strings.ToLower(s))
+	return ok
+}
+
+// serverFieldAliases are short names accepted besides the JSON names of the
+// VmData fields.
+var serverFieldAliases = map[string]string{
+	"dc":      "dcidentifier",
+	"tag":     "tags",
+	"project": "projectid",
+	"ip":      "default_ip",
+	"ipv6":    "default_ipv6",
+	"name":    "hostname",
+	"id":      "identifier",
+	"type":    "vmtype",
+}
+
+var (
+	serverFieldsOnce sync.Once
+	serverFields     map[string][]int
+	serverFieldNames []string
+)
+
+// serverField returns the index of the VmData field named after its JSON
+// name or an alias, ignoring case.
+func serverField(name string) ([]int, error) {
+	serverFieldsOnce.Do(func() {
+		serverFields = make(map[string][]int)
+
+		t := reflect.TypeOf(VmData{})
+		for i := 0; i < t.NumField(); i++ {
+			tag, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+			if tag == "" || tag == "-" {
+				continue
+			}
+			serverFields[strings.ToLower(tag)] = t.Field(i).Index
+			serverFieldNames = append(serverFieldNames, tag)
+		}
+		for alias, name := range serverFieldAliases {
+			serverFields[alias] = serverFields[name]
+			serverFieldNames = append(serverFieldNames, alias)
+		}
+		sort.Strings(serverFieldNames)
+	})
+
+	key := strings.ToLower(name)
+	if alias, ok := serverFieldAliases[key]; ok {
+		key = alias
+	}
+	index, ok := serverFields[key]
+	if !ok {
+		return nil, fmt.Errorf("vpsie: unknown server field %q", name)
+	}
+
+	return index, nil
+}
+
+// ServerFields lists the field names accepted by ParseServerFilter,
+// SortServers and SelectServerFields.
+func ServerFields() []string {
+	serverField("")
+	return append([]string(nil), serverFieldNames...)
+}
+
+// fieldValue returns the field of vm, dereferenced. It is invalid for nil
+// pointers.
+func fieldValue(vm *VmData, index []int) reflect.Value {
+	v := reflect.ValueOf(vm).Elem().FieldByIndex(index)
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+
+	return v
+}
+
+// compareValues orders two field values: nil first, numbers numerically and
+// everything else as case-insensitive text.
+func compareValues(a, b reflect.Value) int {
+	switch {
+	case !a.IsValid() || !b.IsValid():
+		switch {
+		case a.IsValid():
+			return 1
+		case b.IsValid():
+			return -1
+		}
+		return 0
+	case a.CanInt():
+		return cmpOrdered(a.Int(), b.Int())
+	case a.CanFloat():
+		return cmpOrdered(a.Float(), b.Float())
+	}
+
+	return strings.Compare(strings.ToLower(fieldString(a)), strings.ToLower(fieldString(b)))
+}
+
+func cmpOrdered[T int64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func fieldString(v reflect.Value) string {
+	if tags, ok := v.Interface().(TagList); ok {
+		return strings.Join(tags, ",")
+	}
+
+	return fmt.Sprint(v.Interface())
+}
+
+// SortServers sorts servers by the given fields, in order of precedence. A
+// field prefixed with - sorts in descending order.
+func SortServers(servers []VmData, fields ...string) error {
+	type key struct {
+		index []int
+		desc  bool
+	}
+
+	keys := make([]key, len(fields))
+	for i, field := range fields {
+		name, desc := strings.CutPrefix(field, "-")
+		index, err := serverField(name)
+		if err != nil {
+			return err
+		}
+		keys[i] = key{index: index, desc: desc}
+	}
+
+	sort.SliceStable(servers, func(i, j int) bool {
+		for _, k := range keys {
+			c := compareValues(fieldValue(&servers[i], k.index), fieldValue(&servers[j], k.index))
+			if k.desc {
+				c = -c
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+
+	return nil
+}
+
+// SelectServerFields returns the given fields of every server, keyed by the
+// names passed in. Nil pointers become nil.
+func SelectServerFields(servers []VmData, fields ...string) ([]map[string]interface{}, error) {
+	indexes := make([][]int, len(fields))
+	for i, field := range fields {
+		index, err := serverField(field)
+		if err != nil {
+			return nil, err
+		}
+		indexes[i] = index
+	}
+
+	rows := make([]map[string]interface{}, len(servers))
+	for i := range servers {
+		row := make(map[string]interface{}, len(fields))
+		for j, field := range fields {
+			if v := fieldValue(&servers[i], indexes[j]); v.IsValid() {
+				row[field] = v.Interface()
+			} else {
+				row[field] = nil
+			}
+		}
+		rows[i] = row
+	}
+
+	return rows, nil
+}
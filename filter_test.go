@@ -0,0 +1,137 @@
+package govpsie
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func filterTestServers() []VmData {
+	return []VmData{
+		{Hostname: "web-1", DcIdentifier: "ams1", State: "running", Power: 1, Cpu: 4, Ram: 8192, ProjectID: 7, Tags: TagList{"prod", "web"}},
+		{Hostname: "web-2", DcIdentifier: "ams1", State: "stopped", Power: 0, Cpu: 2, Ram: 4096, ProjectID: 7, Tags: TagList{"staging"}},
+		{Hostname: "db-1", DcIdentifier: "fra1", Power: 1, Cpu: 8, Ram: 16384, ProjectID: 9, Tags: TagList{"prod"}},
+		{Hostname: "test-1", DcIdentifier: "fra1", State: "running", Cpu: 1, Ram: 1024},
+	}
+}
+
+func hostnames(servers []VmData) string {
+	names := make([]string, len(servers))
+	for i, vm := range servers {
+		names[i] = vm.Hostname
+	}
+	return strings.Join(names, ",")
+}
+
+func TestWhere(t *testing.T) {
+	servers := filterTestServers()
+
+	tests := []struct {
+		filter ServerFilter
+		want   string
+	}{
+		{Where(Tag("prod"), StateIs(ServerRunning)), "web-1,db-1"},
+		{Where(DC("AMS1"), CPUAtLeast(4)), "web-1"},
+		{Where(InProject(7), Not(StateIs("running"))), "web-2"},
+		{AnyOf(HostnameMatches("db-*"), RAMAtLeast(8000)), "web-1,db-1"},
+		{Where(), "web-1,web-2,db-1,test-1"},
+	}
+
+	for i, tt := range tests {
+		if got := hostnames(tt.filter.Filter(servers)); got != tt.want {
+			t.Errorf("%d: got %s, want %s", i, got, tt.want)
+		}
+	}
+}
+
+func TestParseServerFilter(t *testing.T) {
+	servers := filterTestServers()
+
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"dc=ams1 and power=1", "web-1"},
+		{"dc=fra1 power=1", "db-1"},
+		{"tag=prod and (state=running or cpu>=8)", "web-1,db-1"},
+		{"not hostname~'web-*'", "db-1,test-1"},
+		{"ram > 4096 or project = 7", "web-1,web-2,db-1"},
+		{`name="web-2"`, "web-2"},
+		{"tags!=prod and cpu<4", "web-2,test-1"},
+		{"tag~st*", "web-2"},
+		{"state=running", "web-1,db-1,test-1"},
+		{"dc=ams1\r\nand power=1", "web-1"},
+		{"name=voilà", ""},
+		{"", "web-1,web-2,db-1,test-1"},
+	}
+
+	for _, tt := range tests {
+		f, err := ParseServerFilter(tt.query)
+		if err != nil {
+			t.Fatalf("ParseServerFilter(%q): %v", tt.query, err)
+		}
+		if got := hostnames(f.Filter(servers)); got != tt.want {
+			t.Errorf("%q: got %s, want %s", tt.query, got, tt.want)
+		}
+	}
+
+	for _, query := range []string{"dc", "dc=", "nope=1", "cpu=four", "(dc=ams1", "dc=ams1)", "tag>prod", "dc='ams1", "dc ! ams1", "dc=ams1 or", "name=a\rb"} {
+		if _, err := ParseServerFilter(query); err == nil {
+			t.Errorf("ParseServerFilter(%q) succeeded, want an error", query)
+		}
+	}
+}
+
+func TestSortServers(t *testing.T) {
+	servers := filterTestServers()
+
+	if err := SortServers(servers, "dc", "-cpu"); err != nil {
+		t.Fatal(err)
+	}
+	if got := hostnames(servers); got != "web-1,web-2,db-1,test-1" {
+		t.Errorf("got %s", got)
+	}
+
+	if err := SortServers(servers, "hostname"); err != nil {
+		t.Fatal(err)
+	}
+	if got := hostnames(servers); got != "db-1,test-1,web-1,web-2" {
+		t.Errorf("got %s", got)
+	}
+
+	if err := SortServers(servers, "bogus"); err == nil {
+		t.Error("sorting by an unknown field succeeded")
+	}
+}
+
+func TestSelectServerFields(t *testing.T) {
+	rows, err := SelectServerFields(filterTestServers()[:1], "hostname", "cpu", "notes", "tag")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{"hostname": "web-1", "cpu": int64(4), "notes": nil, "tag": TagList{"prod", "web"}}
+	if !reflect.DeepEqual(rows[0], want) {
+		t.Errorf("got %#v, want %#v", rows[0], want)
+	}
+}
+
+func TestTagListUnmarshal(t *testing.T) {
+	tests := map[string]TagList{
+		`["a","b"]`:                      {"a", "b"},
+		`[{"name":"a"},{"tagName":"b"}]`: {"a", "b"},
+		`"a, b,"`:                        {"a", "b"},
+		`null`:                           nil,
+	}
+
+	for in, want := range tests {
+		var got TagList
+		if err := json.Unmarshal([]byte(in), &got); err != nil {
+			t.Fatalf("%s: %v", in, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got %#v, want %#v", in, got, want)
+		}
+	}
+}
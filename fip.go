@@ -21,6 +21,8 @@ type fipServiceHandler struct {
 var _ FipService = &fipServiceHandler{}
 
 func (f *fipServiceHandler) AssignFloatingIP(ctx context.Context) error {
+	ctx = withOperation(ctx, "FipService", "AssignFloatingIP")
+
 	path := fmt.Sprintf("%s/create/ranges", fipBasePath)
 
 	req, err := f.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -32,6 +34,8 @@ func (f *fipServiceHandler) AssignFloatingIP(ctx context.Context) error {
 }
 
 func (f *fipServiceHandler) UnassignFloatingIP(ctx context.Context, id string) error {
+	ctx = withOperation(ctx, "FipService", "UnassignFloatingIP")
+
 	path := fmt.Sprintf("%s/remove/public/ip", fipBasePath)
 
 	anassignReq := struct {
@@ -51,6 +55,8 @@ func (f *fipServiceHandler) UnassignFloatingIP(ctx context.Context, id string) e
 }
 
 func (f *fipServiceHandler) CreateFloatingIP(ctx context.Context, vmIdentifier, dcIdentifier, ipType string) error {
+	ctx = withOperation(ctx, "FipService", "CreateFloatingIP")
+
 	crateReq := struct {
 		VmIdentifier string `json:"vmIdentifier"`
 		DcIdentifier string `json:"dcIdentifier"`
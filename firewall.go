@@ -27,7 +27,12 @@ type Macros struct {
 type ListMacrosRoot []Macros
 
 func (f *firewallServiceHandler) ListMacros(ctx context.Context, options *ListOptions) ([]Macros, error) {
-	path := fmt.Sprintf("%s/macros", firewallBasePath)
+	ctx = withOperation(ctx, "FirewallService", "ListMacros")
+
+	path, err := addOptions(fmt.Sprintf("%s/macros", firewallBasePath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := f.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -44,6 +49,8 @@ func (f *firewallServiceHandler) ListMacros(ctx context.Context, options *ListOp
 }
 
 func (f *firewallServiceHandler) RemoveGroupVm(ctx context.Context, vmId, groupId string) error {
+	ctx = withOperation(ctx, "FirewallService", "RemoveGroupVm")
+
 	path := fmt.Sprintf("%s/detach/group", firewallBasePath)
 
 	removeReq := struct {
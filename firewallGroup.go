@@ -12,6 +12,7 @@ var firewallGroupBasePath = "/apps/v2/firewall"
 type FirewallGroupService interface {
 	Create(ctx context.Context, groupName string, firewallUpdateReq []FirewallUpdateReq) error
 	List(ctx context.Context, options *ListOptions) ([]FirewallGroupListData, error)
+	ListWithResponse(ctx context.Context, options *ListOptions) ([]FirewallGroupListData, *Response, error)
 	Get(ctx context.Context, fwGroupId string) (*FirewallGroupDetailData, error)
 	Delete(ctx context.Context, fwGroupId string) error
 	Update(ctx context.Context, fwGroupReq *FirewallUpdateReq, fwGroupId string) error
@@ -146,6 +147,8 @@ type IpsetObj struct {
 }
 
 func (f *firewallGroupServiceHandler) Create(ctx context.Context, groupName string, firewallUpdateReq []FirewallUpdateReq) error {
+	ctx = withOperation(ctx, "FirewallGroupService", "Create")
+
 	fwGroupReq := struct {
 		GroupName string              `json:"groupName"`
 		Rules     []FirewallUpdateReq `json:"rules,omitempty"`
@@ -165,24 +168,37 @@ func (f *firewallGroupServiceHandler) Create(ctx context.Context, groupName stri
 }
 
 func (f *firewallGroupServiceHandler) List(ctx context.Context, options *ListOptions) ([]FirewallGroupListData, error) {
-	path := fmt.Sprintf("%s/groups", firewallGroupBasePath)
+	items, _, err := f.ListWithResponse(ctx, options)
+	return items, err
+}
+
+func (f *firewallGroupServiceHandler) ListWithResponse(ctx context.Context, options *ListOptions) ([]FirewallGroupListData, *Response, error) {
+	ctx = withOperation(ctx, "FirewallGroupService", "List")
+
+	path, err := addOptions(fmt.Sprintf("%s/groups", firewallGroupBasePath), options)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := f.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	fwGroups := new(ListFirewallGroupsRoot)
 
-	if err = f.client.Do(ctx, req, &fwGroups); err != nil {
-		return nil, err
+	resp, err := f.client.DoWithResponse(ctx, req, &fwGroups)
+	if err != nil {
+		return nil, resp, err
 	}
 
-	return fwGroups.Data, nil
+	return fwGroups.Data, resp, nil
 
 }
 
 func (f *firewallGroupServiceHandler) Get(ctx context.Context, fwGroupId string) (*FirewallGroupDetailData, error) {
+	ctx = withOperation(ctx, "FirewallGroupService", "Get")
+
 	path := fmt.Sprintf("%s/group/%s", firewallGroupBasePath, fwGroupId)
 
 	req, err := f.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -200,6 +216,8 @@ func (f *firewallGroupServiceHandler) Get(ctx context.Context, fwGroupId string)
 }
 
 func (f *firewallGroupServiceHandler) Delete(ctx context.Context, fwGroupId string) error {
+	ctx = withOperation(ctx, "FirewallGroupService", "Delete")
+
 	path := fmt.Sprintf("%s/delete/group", firewallGroupBasePath)
 
 	delReq := struct {
@@ -217,6 +235,8 @@ func (f *firewallGroupServiceHandler) Delete(ctx context.Context, fwGroupId stri
 }
 
 func (f *firewallGroupServiceHandler) AssignToVpsie(ctx context.Context, groupId string, vmId string) error {
+	ctx = withOperation(ctx, "FirewallGroupService", "AssignToVpsie")
+
 	path := fmt.Sprintf("%s/setGroupVm", firewallGroupBasePath)
 
 	assignReq := struct {
@@ -236,6 +256,8 @@ func (f *firewallGroupServiceHandler) AssignToVpsie(ctx context.Context, groupId
 }
 
 func (f *firewallGroupServiceHandler) AttachToVpsie(ctx context.Context, groupId, vmId string) error {
+	ctx = withOperation(ctx, "FirewallGroupService", "AttachToVpsie")
+
 	path := fmt.Sprintf("%s/attach/group", firewallGroupBasePath)
 
 	assignReq := struct {
@@ -255,6 +277,8 @@ func (f *firewallGroupServiceHandler) AttachToVpsie(ctx context.Context, groupId
 }
 
 func (f *firewallGroupServiceHandler) DetachFromVpsie(ctx context.Context, groupId, vmId string) error {
+	ctx = withOperation(ctx, "FirewallGroupService", "DetachFromVpsie")
+
 	path := fmt.Sprintf("%s/detach/group", firewallGroupBasePath)
 
 	assignReq := struct {
@@ -274,6 +298,8 @@ func (f *firewallGroupServiceHandler) DetachFromVpsie(ctx context.Context, group
 }
 
 func (f *firewallGroupServiceHandler) Update(ctx context.Context, fwGroupReq *FirewallUpdateReq, fwGroupId string) error {
+	ctx = withOperation(ctx, "FirewallGroupService", "Update")
+
 	path := fmt.Sprintf("%s/groups/%s", firewallGroupBasePath, fwGroupId)
 
 	req, err := f.client.NewRequest(ctx, http.MethodPost, path, fwGroupReq)
@@ -285,6 +311,8 @@ func (f *firewallGroupServiceHandler) Update(ctx context.Context, fwGroupReq *Fi
 }
 
 func (f *firewallGroupServiceHandler) GetFirewallGroup(ctx context.Context, fwGroupId string) (*FirewallGroupDetailData, error) {
+	ctx = withOperation(ctx, "FirewallGroupService", "GetFirewallGroup")
+
 	path := fmt.Sprintf("%s/group/%s", firewallGroupBasePath, fwGroupId)
 
 	req, err := f.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -302,6 +330,8 @@ func (f *firewallGroupServiceHandler) GetFirewallGroup(ctx context.Context, fwGr
 }
 
 func (f *firewallGroupServiceHandler) DeleteFirewallGroupOfServer(ctx context.Context, groupId, vmId string) error {
+	ctx = withOperation(ctx, "FirewallGroupService", "DeleteFirewallGroupOfServer")
+
 	path := fmt.Sprintf("%s/firewall/removeGroupVm", firewallGroupBasePath)
 
 	delReq := struct {
@@ -1,24 +1,44 @@
-package govpsie
+package govpsie_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
-	"golang.org/x/oauth2"
+	"github.com/vpsieinc/govpsie"
+	"github.com/vpsieinc/govpsie/vpsietest"
 )
 
 func TestFirewallGroupServiceHandlerDelete(t *testing.T) {
-	client := NewClient(oauth2.NewClient(context.Background(), nil))
+	srv := vpsietest.NewServer()
+	defer srv.Close()
 
-	client.SetUserAgent(userAgent)
-	client.SetRequestHeaders(map[string]string{
-		"Vpsie-Auth": "sampleToken",
-	})
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
 
-	err := client.FirewallGroup.Delete(context.Background(), "7dbcea52-f695-11ee-8bba-0050569c68dc")
+	ctx := context.Background()
+	if err := client.FirewallGroup.Create(ctx, "web", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	groups, err := client.FirewallGroup.List(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups) != 1 {
+		t.Fatalf("got %d firewall groups, want 1", len(groups))
+	}
+
+	err = client.FirewallGroup.Delete(ctx, groups[0].Identifier)
 
 	if err != nil {
 		t.Error(err)
 	}
 
+	err = client.FirewallGroup.Delete(ctx, groups[0].Identifier)
+	if !errors.Is(err, govpsie.ErrNotFound) {
+		t.Errorf("deleting twice returned %v, want ErrNotFound", err)
+	}
 }
@@ -11,6 +11,7 @@ var gatewayPath = "/apps/v2/gateways"
 
 type GatewayService interface {
 	List(ctx context.Context, options *ListOptions) ([]Gateway, error)
+	ListWithResponse(ctx context.Context, options *ListOptions) ([]Gateway, *Response, error)
 	Delete(ctx context.Context, ipId int) error
 	Create(ctx context.Context, createReq *CreateGatewayReq) error
 	Get(ctx context.Context, id int64) (*Gateway, error)
@@ -60,6 +61,7 @@ type Gateway struct {
 	DcIdentifier         string       `json:"dcIdentifier"`
 	CreatedBy            string       `json:"created_by"`
 	AttachedVms          []AttachedVM `json:"attachedVms"`
+	Tags                 TagList      `json:"tags,omitempty"`
 }
 
 type AttachedVM struct {
@@ -74,21 +76,34 @@ type CreateGatewayReq struct {
 }
 
 func (s *gatewayServiceHandler) List(ctx context.Context, options *ListOptions) ([]Gateway, error) {
-	path := fmt.Sprintf("%s/ips", gatewayPath)
+	items, _, err := s.ListWithResponse(ctx, options)
+	return items, err
+}
+
+func (s *gatewayServiceHandler) ListWithResponse(ctx context.Context, options *ListOptions) ([]Gateway, *Response, error) {
+	ctx = withOperation(ctx, "GatewayService", "List")
+
+	path, err := addOptions(fmt.Sprintf("%s/ips", gatewayPath), options)
+	if err != nil {
+		return nil, nil, err
+	}
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var root ListGatewayRoot
-	if err := s.client.Do(ctx, req, &root); err != nil {
-		return nil, err
+	resp, err := s.client.DoWithResponse(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
 	}
 
-	return root.Data.Rows, nil
+	return root.Data.Rows, resp, nil
 }
 
 func (s *gatewayServiceHandler) Create(ctx context.Context, createReq *CreateGatewayReq) error {
+	ctx = withOperation(ctx, "GatewayService", "Create")
+
 	path := fmt.Sprintf("%s/add/ip", gatewayPath)
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, createReq)
 	if err != nil {
@@ -99,6 +114,8 @@ func (s *gatewayServiceHandler) Create(ctx context.Context, createReq *CreateGat
 }
 
 func (s *gatewayServiceHandler) Delete(ctx context.Context, ipId int) error {
+	ctx = withOperation(ctx, "GatewayService", "Delete")
+
 	path := fmt.Sprintf("%s/delete/ip", gatewayPath)
 
 	delReq := struct {
@@ -117,6 +134,8 @@ func (s *gatewayServiceHandler) Delete(ctx context.Context, ipId int) error {
 
 
 func (g *gatewayServiceHandler) Get(ctx context.Context, id int64) (*Gateway, error) {
+	ctx = withOperation(ctx, "GatewayService", "Get")
+
 	path := fmt.Sprintf("%s/ips?ipId=%d", gatewayPath, id)
 	req, err := g.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -137,6 +156,8 @@ func (g *gatewayServiceHandler) Get(ctx context.Context, id int64) (*Gateway, er
 }
 
 func (g *gatewayServiceHandler) AttachVM(ctx context.Context, id int64, vms []string, ignoreLegacyVms int64) error {
+	ctx = withOperation(ctx, "GatewayService", "AttachVM")
+
 	path := fmt.Sprintf("%s/attach/vms", gatewayPath)
 
 	attReq := struct {
@@ -158,6 +179,8 @@ func (g *gatewayServiceHandler) AttachVM(ctx context.Context, id int64, vms []st
 }
 
 func (g *gatewayServiceHandler) DetachVM(ctx context.Context, id int64, mapping_id []int64) error {
+	ctx = withOperation(ctx, "GatewayService", "DetachVM")
+
 	path := fmt.Sprintf("%s/detach/vms", gatewayPath)
 
 	detReq := struct {
@@ -4,11 +4,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"sync"
+	"time"
 )
 
 const (
@@ -29,6 +31,23 @@ type Client struct {
 	UserAgent string
 	headers   map[string]string
 
+	// Logger for debug logging of requests and responses, nil disables it.
+	logger *slog.Logger
+
+	// Middleware wrapping every call to Do, outermost first, and editors
+	// run on every request created by NewRequest.
+	middleware     []Middleware
+	requestEditors []RequestEditor
+
+	// Retry policy applied by Do, nil disables retries.
+	retryPolicy *RetryPolicy
+
+	// Client side rate limiting and the last rate limit seen from the API.
+	rateMu           sync.Mutex
+	limiter          RateLimiter
+	endpointLimiters map[string]RateLimiter
+	rate             Rate
+
 	// services
 	Account       AccountService
 	Project       ProjectsService
@@ -56,6 +75,9 @@ type Client struct {
 	AccessToken   AccessTokenService
 	Billing       BillingService
 	Monitoring    MonitoringService
+	OS            OSService
+	Tag           TagService
+	Statistics    StatisticsService
 }
 
 type ErrorRsp struct {
@@ -87,9 +109,10 @@ func NewClient(httpClient *http.Client) *Client {
 	baseURL, _ := url.Parse(defaultBaseURL)
 
 	c := &Client{
-		client:    httpClient,
-		BaseURL:   baseURL,
-		UserAgent: userAgent,
+		client:      httpClient,
+		BaseURL:     baseURL,
+		UserAgent:   userAgent,
+		retryPolicy: DefaultRetryPolicy(),
 	}
 
 	c.Account = &accountServiceHandler{client: c}
@@ -118,6 +141,9 @@ func NewClient(httpClient *http.Client) *Client {
 	c.AccessToken = &accessTokenServiceHandler{client: c}
 	c.Billing = &billingServiceHandler{client: c}
 	c.Monitoring = &monitoringServiceHandler{client: c}
+	c.OS = &osServiceHandler{client: c}
+	c.Tag = &tagServiceHandler{client: c}
+	c.Statistics = &statisticsServiceHandler{client: c}
 
 	c.headers = make(map[string]string)
 	return c
@@ -148,6 +174,7 @@ func (c *Client) SetBaseURL(baseURL string) error {
 }
 
 // value pointed to by body is JSON encoded and included in as the request body.
+// The body is kept in memory so that retries can resend the same payload.
 func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
 	u, err := c.BaseURL.Parse(urlStr)
 	if err != nil {
@@ -159,10 +186,14 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 		return nil, fmt.Errorf("request URL host %q does not match base URL host %q", u.Host, c.BaseURL.Host)
 	}
 
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
 	var req *http.Request
 	switch method {
 	case http.MethodGet, http.MethodHead, http.MethodOptions:
-		req, err = http.NewRequest(method, u.String(), nil)
+		req, err = http.NewRequestWithContext(ctx, method, u.String(), nil)
 		if err != nil {
 			return nil, err
 		}
@@ -176,7 +207,9 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 			}
 		}
 
-		req, err = http.NewRequest(method, u.String(), buf)
+		// http.NewRequest sets GetBody for a *bytes.Reader, which Do uses to
+		// replay the payload on retries.
+		req, err = http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(buf.Bytes()))
 		if err != nil {
 			return nil, err
 		}
@@ -190,58 +223,124 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 	req.Header.Set("Accept", mediaType)
 	req.Header.Set("User-Agent", c.UserAgent)
 
+	if err := c.editRequest(req); err != nil {
+		return nil, err
+	}
+
 	return req, nil
 }
 
+// Do sends req and decodes the JSON response body into v.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) error {
+	_, err := c.DoWithResponse(ctx, req, v)
+	return err
+}
 
-	req = req.WithContext(ctx)
-	res, err := c.client.Do(req)
-	if err != nil {
-		return err
+// DoWithResponse is like Do but also returns the response metadata. The
+// Response is returned whenever the API answered, including alongside an
+// *APIError.
+func (c *Client) DoWithResponse(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	// Keep the operation recorded by NewRequest when the caller passes a
+	// different context.
+	if op, ok := OperationFromContext(req.Context()); ok {
+		if _, ok := OperationFromContext(ctx); !ok {
+			ctx = WithOperation(ctx, op)
+		}
 	}
 
-	defer res.Body.Close()
+	return c.chain(c.do)(req.WithContext(ctx), v)
+}
 
-	body, err := io.ReadAll(res.Body)
+// do is the innermost Handler of the middleware chain.
+func (c *Client) do(req *http.Request, v interface{}) (*Response, error) {
+	ctx := req.Context()
+	res, body, err := c.send(ctx, req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	response := newResponse(req, res, body)
 	if res.StatusCode == http.StatusNoContent {
-		return nil
+		return response, nil
 	}
 
 	if res.StatusCode < http.StatusOK || res.StatusCode >= 300 {
-		var errRsp ErrorRsp
-		if err := json.Unmarshal(body, &errRsp); err != nil {
-			return err
-		}
-
-		return errors.New(errRsp.Message)
+		return response, newAPIError(req, res, body)
 	}
 
 	// VPSie API may return HTTP 200 with {"error":true,"message":"..."} in body.
 	// Check for this case before unmarshaling into the target struct.
-	var apiErr struct {
-		Error   bool   `json:"error"`
-		Message string `json:"message"`
-	}
-	if err := json.Unmarshal(body, &apiErr); err == nil && apiErr.Error {
-		msg := apiErr.Message
-		if msg == "" {
-			msg = "VPSie API returned error with HTTP 200"
+	var errRsp ErrorRsp
+	if err := json.Unmarshal(body, &errRsp); err == nil && errRsp.Error {
+		apiErr := newAPIError(req, res, body)
+		if apiErr.Message == "" {
+			apiErr.Message = "VPSie API returned error with HTTP 200"
 		}
-		return errors.New(msg)
+		return response, apiErr
 	}
 
 	if v != nil {
 		if err := json.Unmarshal(body, v); err != nil {
-			return err
+			return response, err
 		}
 	}
 
-	return nil
+	return response, nil
+}
+
+// send performs req, retrying transient failures according to the retry
+// policy, and returns the response together with its fully read body.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, []byte, error) {
+	policy := c.retryPolicy
+	attempts := policy.attempts(req)
+
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && req.Body != nil {
+			if req.GetBody == nil {
+				return nil, nil, fmt.Errorf("cannot retry %s %s: request body is not replayable", req.Method, req.URL)
+			}
+			rewound, err := req.GetBody()
+			if err != nil {
+				return nil, nil, err
+			}
+			req.Body = rewound
+		}
+
+		if err := c.waitRateLimit(ctx, req); err != nil {
+			return nil, nil, err
+		}
+
+		c.logRequest(ctx, req, attempt)
+		start := time.Now()
+		res, err := c.client.Do(req)
+		var body []byte
+		if err == nil {
+			c.updateRate(res)
+			body, err = io.ReadAll(res.Body)
+			res.Body.Close()
+		}
+		c.logResponse(ctx, req, res, body, time.Since(start), err)
+
+		if attempt >= attempts || !policy.shouldRetry(res, err) {
+			if err != nil {
+				return nil, nil, err
+			}
+			return res, body, nil
+		}
+
+		// Give up rather than sleep longer than the policy allows or past
+		// the deadline of the caller.
+		wait, ok := policy.backoff(attempt, res)
+		if deadline, hasDeadline := ctx.Deadline(); !ok || hasDeadline && time.Now().Add(wait).After(deadline) {
+			if err != nil {
+				return nil, nil, err
+			}
+			return res, body, nil
+		}
+		if err := sleep(ctx, wait); err != nil {
+			return nil, nil, err
+		}
+	}
 }
 
 // StreamToString converts a reader to a string
@@ -0,0 +1,22 @@
+//go:build ignore
+
+// gen writes mocks_gen.go from the service interfaces of the parent package.
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/vpsieinc/govpsie/govpsiemock/internal/mockgen"
+)
+
+func main() {
+	src, err := mockgen.Generate("..")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("mocks_gen.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
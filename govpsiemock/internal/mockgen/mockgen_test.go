@@ -0,0 +1,23 @@
+package mockgen
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestGeneratedFileUpToDate(t *testing.T) {
+	want, err := Generate("../../..")
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+
+	got, err := os.ReadFile("../../mocks_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Error("mocks_gen.go is out of date, run go generate ./govpsiemock")
+	}
+}
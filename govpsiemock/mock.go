@@ -0,0 +1,83 @@
+// Package govpsiemock provides configurable fakes of the govpsie service
+// interfaces for unit tests.
+//
+// Each fake has a XxxFunc field per method. A call runs the matching field
+// when it is set; otherwise it returns zero values and, for methods returning
+// an error, an error wrapping ErrNotStubbed. Every call is recorded.
+//
+//	mocks := govpsiemock.NewServices()
+//	mocks.Server.ListFunc = func(ctx context.Context, opt *govpsie.ListOptions) ([]govpsie.VmData, error) {
+//		return []govpsie.VmData{{Hostname: "web-1"}}, nil
+//	}
+//
+//	client := govpsie.NewClient(nil)
+//	mocks.Install(client)
+//
+// The fakes are generated from the interfaces; run go generate after changing
+// a service interface.
+package govpsiemock
+
+//go:generate go run gen.go
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNotStubbed is returned by fake methods that were called without a stub.
+var ErrNotStubbed = errors.New("govpsiemock: method not stubbed")
+
+// Call is one recorded method call.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// recorder records the calls made to a fake. It is safe for concurrent use.
+type recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *recorder) record(method string, args []interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns every call made to the fake, in order.
+func (r *recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the calls made to method, in order.
+func (r *recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var calls []Call
+	for _, c := range r.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+
+	return calls
+}
+
+// ResetCalls forgets the recorded calls.
+func (r *recorder) ResetCalls() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = nil
+}
+
+func notStubbed(method string) error {
+	return fmt.Errorf("%w: %s", ErrNotStubbed, method)
+}
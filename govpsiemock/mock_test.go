@@ -0,0 +1,51 @@
+package govpsiemock_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/vpsieinc/govpsie"
+	"github.com/vpsieinc/govpsie/govpsiemock"
+)
+
+func TestStubAndRecord(t *testing.T) {
+	mocks := govpsiemock.NewServices()
+	mocks.Server.ListFunc = func(ctx context.Context, opt *govpsie.ListOptions) ([]govpsie.VmData, error) {
+		return []govpsie.VmData{{Hostname: "web-1"}}, nil
+	}
+
+	client := govpsie.NewClient(nil)
+	mocks.Install(client)
+
+	opt := &govpsie.ListOptions{Page: 2}
+	servers, err := client.Server.List(context.Background(), opt)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(servers) != 1 || servers[0].Hostname != "web-1" {
+		t.Errorf("List = %+v", servers)
+	}
+
+	calls := mocks.Server.CallsTo("List")
+	if len(calls) != 1 || calls[0].Args[1] != opt {
+		t.Errorf("calls = %+v", calls)
+	}
+}
+
+func TestNotStubbed(t *testing.T) {
+	mocks := govpsiemock.NewServices()
+
+	_, err := mocks.Server.GetServerByIdentifier(context.Background(), "abc")
+	if !errors.Is(err, govpsiemock.ErrNotStubbed) {
+		t.Errorf("err = %v, want ErrNotStubbed", err)
+	}
+	if got := len(mocks.Server.Calls()); got != 1 {
+		t.Errorf("recorded %d calls, want 1", got)
+	}
+
+	mocks.Server.ResetCalls()
+	if got := len(mocks.Server.Calls()); got != 0 {
+		t.Errorf("recorded %d calls after reset, want 0", got)
+	}
+}
@@ -0,0 +1,2993 @@
+// Code generated by mockgen; DO NOT EDIT.
+
+package govpsiemock
+
+import (
+	"context"
+	"time"
+
+	"github.com/vpsieinc/govpsie"
+)
+
+// Services holds a fake for every service of govpsie.Client.
+type Services struct {
+	Account       *AccountService
+	Project       *ProjectsService
+	Server        *ServerService
+	Image         *ImagesService
+	SShKey        *SshkeysService
+	Profile       *ProfilesService
+	Backup        *BackupsService
+	IP            *IPsService
+	Domain        *DomainService
+	Fip           *FipService
+	FirewallGroup *FirewallGroupService
+	Firewall      *FirewallService
+	Storage       *StorageService
+	Snapshot      *SnapshotService
+	Logs          *LogsService
+	DataCenter    *DataCenterService
+	LB            *LBsService
+	Scripts       *ScriptsService
+	Pending       *PendingService
+	Gateway       *GatewayService
+	VPC           *VPCService
+	Bucket        *BucketService
+	K8s           *K8sService
+	AccessToken   *AccessTokenService
+	Billing       *BillingService
+	Monitoring    *MonitoringService
+	OS            *OSService
+	Tag           *TagService
+	Statistics    *StatisticsService
+}
+
+// NewServices returns a fresh fake for every service.
+func NewServices() *Services {
+	return &Services{
+		Account:       &AccountService{},
+		Project:       &ProjectsService{},
+		Server:        &ServerService{},
+		Image:         &ImagesService{},
+		SShKey:        &SshkeysService{},
+		Profile:       &ProfilesService{},
+		Backup:        &BackupsService{},
+		IP:            &IPsService{},
+		Domain:        &DomainService{},
+		Fip:           &FipService{},
+		FirewallGroup: &FirewallGroupService{},
+		Firewall:      &FirewallService{},
+		Storage:       &StorageService{},
+		Snapshot:      &SnapshotService{},
+		Logs:          &LogsService{},
+		DataCenter:    &DataCenterService{},
+		LB:            &LBsService{},
+		Scripts:       &ScriptsService{},
+		Pending:       &PendingService{},
+		Gateway:       &GatewayService{},
+		VPC:           &VPCService{},
+		Bucket:        &BucketService{},
+		K8s:           &K8sService{},
+		AccessToken:   &AccessTokenService{},
+		Billing:       &BillingService{},
+		Monitoring:    &MonitoringService{},
+		OS:            &OSService{},
+		Tag:           &TagService{},
+		Statistics:    &StatisticsService{},
+	}
+}
+
+// Install replaces the services of c with the fakes.
+func (s *Services) Install(c *govpsie.Client) {
+	c.Account = s.Account
+	c.Project = s.Project
+	c.Server = s.Server
+	c.Image = s.Image
+	c.SShKey = s.SShKey
+	c.Profile = s.Profile
+	c.Backup = s.Backup
+	c.IP = s.IP
+	c.Domain = s.Domain
+	c.Fip = s.Fip
+	c.FirewallGroup = s.FirewallGroup
+	c.Firewall = s.Firewall
+	c.Storage = s.Storage
+	c.Snapshot = s.Snapshot
+	c.Logs = s.Logs
+	c.DataCenter = s.DataCenter
+	c.LB = s.LB
+	c.Scripts = s.Scripts
+	c.Pending = s.Pending
+	c.Gateway = s.Gateway
+	c.VPC = s.VPC
+	c.Bucket = s.Bucket
+	c.K8s = s.K8s
+	c.AccessToken = s.AccessToken
+	c.Billing = s.Billing
+	c.Monitoring = s.Monitoring
+	c.OS = s.OS
+	c.Tag = s.Tag
+	c.Statistics = s.Statistics
+}
+
+// AccessTokenService is a configurable fake of govpsie.AccessTokenService.
+type AccessTokenService struct {
+	recorder
+
+	ListFunc   func(context.Context, *govpsie.ListOptions) ([]govpsie.AccessToken, error)
+	CreateFunc func(context.Context, string, string, string) error
+	DeleteFunc func(context.Context, string) error
+	UpdateFunc func(context.Context, string, string, string) error
+}
+
+var _ govpsie.AccessTokenService = (*AccessTokenService)(nil)
+
+func (m *AccessTokenService) List(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.AccessToken, r1 error) {
+	m.record("List", []interface{}{ctx, options})
+	if m.ListFunc == nil {
+		r1 = notStubbed("AccessTokenService.List")
+		return
+	}
+	return m.ListFunc(ctx, options)
+}
+
+func (m *AccessTokenService) Create(ctx context.Context, name string, accessToken string, expirationDate string) (r0 error) {
+	m.record("Create", []interface{}{ctx, name, accessToken, expirationDate})
+	if m.CreateFunc == nil {
+		r0 = notStubbed("AccessTokenService.Create")
+		return
+	}
+	return m.CreateFunc(ctx, name, accessToken, expirationDate)
+}
+
+func (m *AccessTokenService) Delete(ctx context.Context, accessTokenIdentifier string) (r0 error) {
+	m.record("Delete", []interface{}{ctx, accessTokenIdentifier})
+	if m.DeleteFunc == nil {
+		r0 = notStubbed("AccessTokenService.Delete")
+		return
+	}
+	return m.DeleteFunc(ctx, accessTokenIdentifier)
+}
+
+func (m *AccessTokenService) Update(ctx context.Context, accessTokenIdentifier string, name string, expirationDate string) (r0 error) {
+	m.record("Update", []interface{}{ctx, accessTokenIdentifier, name, expirationDate})
+	if m.UpdateFunc == nil {
+		r0 = notStubbed("AccessTokenService.Update")
+		return
+	}
+	return m.UpdateFunc(ctx, accessTokenIdentifier, name, expirationDate)
+}
+
+// AccountService is a configurable fake of govpsie.AccountService.
+type AccountService struct {
+	recorder
+
+	LoginFunc        func(context.Context, *govpsie.LoginReq) (*govpsie.Token, error)
+	RefreshTokenFunc func(context.Context, string) (*govpsie.Token, error)
+}
+
+var _ govpsie.AccountService = (*AccountService)(nil)
+
+func (m *AccountService) Login(ctx context.Context, loginCredentials *govpsie.LoginReq) (r0 *govpsie.Token, r1 error) {
+	m.record("Login", []interface{}{ctx, loginCredentials})
+	if m.LoginFunc == nil {
+		r1 = notStubbed("AccountService.Login")
+		return
+	}
+	return m.LoginFunc(ctx, loginCredentials)
+}
+
+func (m *AccountService) RefreshToken(ctx context.Context, refreshToken string) (r0 *govpsie.Token, r1 error) {
+	m.record("RefreshToken", []interface{}{ctx, refreshToken})
+	if m.RefreshTokenFunc == nil {
+		r1 = notStubbed("AccountService.RefreshToken")
+		return
+	}
+	return m.RefreshTokenFunc(ctx, refreshToken)
+}
+
+// BackupsService is a configurable fake of govpsie.BackupsService.
+type BackupsService struct {
+	recorder
+
+	ListFunc                     func(context.Context, *govpsie.ListOptions) ([]govpsie.Backup, error)
+	ListWithResponseFunc         func(context.Context, *govpsie.ListOptions) ([]govpsie.Backup, *govpsie.Response, error)
+	DeleteBackupFunc             func(context.Context, string, string, string) error
+	CreateBackupsFunc            func(context.Context, string, string, string) error
+	ListByServerFunc             func(context.Context, *govpsie.ListOptions, string) ([]govpsie.Backup, error)
+	CreateServerByBackupFunc     func(context.Context, string) error
+	GetFunc                      func(context.Context, string) (*govpsie.Backup, error)
+	EnableAutoBackupFunc         func(context.Context, *govpsie.EnableAutoBackupReq) error
+	RenameFunc                   func(context.Context, string, string) error
+	GetBackupPolicyFunc          func(context.Context, string) (*govpsie.BackupPolicy, error)
+	CreateBackupPolicyFunc       func(context.Context, *govpsie.CreateBackupPolicyReq) error
+	DeleteBackupPolicyFunc       func(context.Context, string, string) error
+	ManageRetainBackupPolicyFunc func(context.Context, string, int) error
+	AttachBackupPolicyFunc       func(context.Context, string, []string) error
+	DetachBackupPolicyFunc       func(context.Context, string, []string) error
+	ListBackupPoliciesFunc       func(context.Context, *govpsie.ListOptions) ([]govpsie.BackupPolicyListDetail, error)
+}
+
+var _ govpsie.BackupsService = (*BackupsService)(nil)
+
+func (m *BackupsService) List(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.Backup, r1 error) {
+	m.record("List", []interface{}{ctx, options})
+	if m.ListFunc == nil {
+		r1 = notStubbed("BackupsService.List")
+		return
+	}
+	return m.ListFunc(ctx, options)
+}
+
+func (m *BackupsService) ListWithResponse(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.Backup, r1 *govpsie.Response, r2 error) {
+	m.record("ListWithResponse", []interface{}{ctx, options})
+	if m.ListWithResponseFunc == nil {
+		r2 = notStubbed("BackupsService.ListWithResponse")
+		return
+	}
+	return m.ListWithResponseFunc(ctx, options)
+}
+
+func (m *BackupsService) DeleteBackup(ctx context.Context, backupIdentifier string, deleteReason string, deleteNote string) (r0 error) {
+	m.record("DeleteBackup", []interface{}{ctx, backupIdentifier, deleteReason, deleteNote})
+	if m.DeleteBackupFunc == nil {
+		r0 = notStubbed("BackupsService.DeleteBackup")
+		return
+	}
+	return m.DeleteBackupFunc(ctx, backupIdentifier, deleteReason, deleteNote)
+}
+
+func (m *BackupsService) CreateBackups(ctx context.Context, vmIdentifier string, name string, notes string) (r0 error) {
+	m.record("CreateBackups", []interface{}{ctx, vmIdentifier, name, notes})
+	if m.CreateBackupsFunc == nil {
+		r0 = notStubbed("BackupsService.CreateBackups")
+		return
+	}
+	return m.CreateBackupsFunc(ctx, vmIdentifier, name, notes)
+}
+
+func (m *BackupsService) ListByServer(ctx context.Context, options *govpsie.ListOptions, vmIdentifier string) (r0 []govpsie.Backup, r1 error) {
+	m.record("ListByServer", []interface{}{ctx, options, vmIdentifier})
+	if m.ListByServerFunc == nil {
+		r1 = notStubbed("BackupsService.ListByServer")
+		return
+	}
+	return m.ListByServerFunc(ctx, options, vmIdentifier)
+}
+
+func (m *BackupsService) CreateServerByBackup(ctx context.Context, backupIdentifier string) (r0 error) {
+	m.record("CreateServerByBackup", []interface{}{ctx, backupIdentifier})
+	if m.CreateServerByBackupFunc == nil {
+		r0 = notStubbed("BackupsService.CreateServerByBackup")
+		return
+	}
+	return m.CreateServerByBackupFunc(ctx, backupIdentifier)
+}
+
+func (m *BackupsService) Get(ctx context.Context, identifer string) (r0 *govpsie.Backup, r1 error) {
+	m.record("Get", []interface{}{ctx, identifer})
+	if m.GetFunc == nil {
+		r1 = notStubbed("BackupsService.Get")
+		return
+	}
+	return m.GetFunc(ctx, identifer)
+}
+
+func (m *BackupsService) EnableAutoBackup(ctx context.Context, enableAutoReq *govpsie.EnableAutoBackupReq) (r0 error) {
+	m.record("EnableAutoBackup", []interface{}{ctx, enableAutoReq})
+	if m.EnableAutoBackupFunc == nil {
+		r0 = notStubbed("BackupsService.EnableAutoBackup")
+		return
+	}
+	return m.EnableAutoBackupFunc(ctx, enableAutoReq)
+}
+
+func (m *BackupsService) Rename(ctx context.Context, backupIdentifier string, newName string) (r0 error) {
+	m.record("Rename", []interface{}{ctx, backupIdentifier, newName})
+	if m.RenameFunc == nil {
+		r0 = notStubbed("BackupsService.Rename")
+		return
+	}
+	return m.RenameFunc(ctx, backupIdentifier, newName)
+}
+
+func (m *BackupsService) GetBackupPolicy(ctx context.Context, identifier string) (r0 *govpsie.BackupPolicy, r1 error) {
+	m.record("GetBackupPolicy", []interface{}{ctx, identifier})
+	if m.GetBackupPolicyFunc == nil {
+		r1 = notStubbed("BackupsService.GetBackupPolicy")
+		return
+	}
+	return m.GetBackupPolicyFunc(ctx, identifier)
+}
+
+func (m *BackupsService) CreateBackupPolicy(ctx context.Context, createReq *govpsie.CreateBackupPolicyReq) (r0 error) {
+	m.record("CreateBackupPolicy", []interface{}{ctx, createReq})
+	if m.CreateBackupPolicyFunc == nil {
+		r0 = notStubbed("BackupsService.CreateBackupPolicy")
+		return
+	}
+	return m.CreateBackupPolicyFunc(ctx, createReq)
+}
+
+func (m *BackupsService) DeleteBackupPolicy(ctx context.Context, policyId string, identifier string) (r0 error) {
+	m.record("DeleteBackupPolicy", []interface{}{ctx, policyId, identifier})
+	if m.DeleteBackupPolicyFunc == nil {
+		r0 = notStubbed("BackupsService.DeleteBackupPolicy")
+		return
+	}
+	return m.DeleteBackupPolicyFunc(ctx, policyId, identifier)
+}
+
+func (m *BackupsService) ManageRetainBackupPolicy(ctx context.Context, policyId string, keep int) (r0 error) {
+	m.record("ManageRetainBackupPolicy", []interface{}{ctx, policyId, keep})
+	if m.ManageRetainBackupPolicyFunc == nil {
+		r0 = notStubbed("BackupsService.ManageRetainBackupPolicy")
+		return
+	}
+	return m.ManageRetainBackupPolicyFunc(ctx, policyId, keep)
+}
+
+func (m *BackupsService) AttachBackupPolicy(ctx context.Context, policyId string, vms []string) (r0 error) {
+	m.record("AttachBackupPolicy", []interface{}{ctx, policyId, vms})
+	if m.AttachBackupPolicyFunc == nil {
+		r0 = notStubbed("BackupsService.AttachBackupPolicy")
+		return
+	}
+	return m.AttachBackupPolicyFunc(ctx, policyId, vms)
+}
+
+func (m *BackupsService) DetachBackupPolicy(ctx context.Context, policyId string, vms []string) (r0 error) {
+	m.record("DetachBackupPolicy", []interface{}{ctx, policyId, vms})
+	if m.DetachBackupPolicyFunc == nil {
+		r0 = notStubbed("BackupsService.DetachBackupPolicy")
+		return
+	}
+	return m.DetachBackupPolicyFunc(ctx, policyId, vms)
+}
+
+func (m *BackupsService) ListBackupPolicies(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.BackupPolicyListDetail, r1 error) {
+	m.record("ListBackupPolicies", []interface{}{ctx, options})
+	if m.ListBackupPoliciesFunc == nil {
+		r1 = notStubbed("BackupsService.ListBackupPolicies")
+		return
+	}
+	return m.ListBackupPoliciesFunc(ctx, options)
+}
+
+// BillingService is a configurable fake of govpsie.BillingService.
+type BillingService struct {
+	recorder
+
+	ListInvoicesFunc        func(context.Context, *govpsie.ListOptions) ([]govpsie.Invoice, error)
+	ListPurchaseLogFunc     func(context.Context, *govpsie.ListOptions) ([]govpsie.PurchaseLog, error)
+	ApplyVoucherFunc        func(context.Context, string) error
+	ListAppliedVouchersFunc func(context.Context, *govpsie.ListOptions) ([]govpsie.AppliedVouchers, error)
+	ListEstimatedUsagesFunc func(context.Context, *govpsie.ListOptions) ([]govpsie.EstimatedUsages, error)
+}
+
+var _ govpsie.BillingService = (*BillingService)(nil)
+
+func (m *BillingService) ListInvoices(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.Invoice, r1 error) {
+	m.record("ListInvoices", []interface{}{ctx, options})
+	if m.ListInvoicesFunc == nil {
+		r1 = notStubbed("BillingService.ListInvoices")
+		return
+	}
+	return m.ListInvoicesFunc(ctx, options)
+}
+
+func (m *BillingService) ListPurchaseLog(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.PurchaseLog, r1 error) {
+	m.record("ListPurchaseLog", []interface{}{ctx, options})
+	if m.ListPurchaseLogFunc == nil {
+		r1 = notStubbed("BillingService.ListPurchaseLog")
+		return
+	}
+	return m.ListPurchaseLogFunc(ctx, options)
+}
+
+func (m *BillingService) ApplyVoucher(ctx context.Context, couponIdentifier string) (r0 error) {
+	m.record("ApplyVoucher", []interface{}{ctx, couponIdentifier})
+	if m.ApplyVoucherFunc == nil {
+		r0 = notStubbed("BillingService.ApplyVoucher")
+		return
+	}
+	return m.ApplyVoucherFunc(ctx, couponIdentifier)
+}
+
+func (m *BillingService) ListAppliedVouchers(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.AppliedVouchers, r1 error) {
+	m.record("ListAppliedVouchers", []interface{}{ctx, options})
+	if m.ListAppliedVouchersFunc == nil {
+		r1 = notStubbed("BillingService.ListAppliedVouchers")
+		return
+	}
+	return m.ListAppliedVouchersFunc(ctx, options)
+}
+
+func (m *BillingService) ListEstimatedUsages(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.EstimatedUsages, r1 error) {
+	m.record("ListEstimatedUsages", []interface{}{ctx, options})
+	if m.ListEstimatedUsagesFunc == nil {
+		r1 = notStubbed("BillingService.ListEstimatedUsages")
+		return
+	}
+	return m.ListEstimatedUsagesFunc(ctx, options)
+}
+
+// BucketService is a configurable fake of govpsie.BucketService.
+type BucketService struct {
+	recorder
+
+	ListFunc                   func(context.Context, *govpsie.ListOptions) ([]govpsie.Bucket, error)
+	ListWithResponseFunc       func(context.Context, *govpsie.ListOptions) ([]govpsie.Bucket, *govpsie.Response, error)
+	GetFunc                    func(context.Context, string) (*govpsie.Bucket, error)
+	CreateFunc                 func(context.Context, *govpsie.CreateBucketReq) error
+	DeleteFunc                 func(context.Context, string, string, string) error
+	ToggleFileListingFunc      func(context.Context, string, bool) (bool, error)
+	CheckFileListingStatusFunc func(context.Context, string) (bool, error)
+	GenerateKeyFunc            func(context.Context, string) error
+	ListBucketKeysFunc         func(context.Context) ([]govpsie.BucketKey, error)
+}
+
+var _ govpsie.BucketService = (*BucketService)(nil)
+
+func (m *BucketService) List(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.Bucket, r1 error) {
+	m.record("List", []interface{}{ctx, options})
+	if m.ListFunc == nil {
+		r1 = notStubbed("BucketService.List")
+		return
+	}
+	return m.ListFunc(ctx, options)
+}
+
+func (m *BucketService) ListWithResponse(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.Bucket, r1 *govpsie.Response, r2 error) {
+	m.record("ListWithResponse", []interface{}{ctx, options})
+	if m.ListWithResponseFunc == nil {
+		r2 = notStubbed("BucketService.ListWithResponse")
+		return
+	}
+	return m.ListWithResponseFunc(ctx, options)
+}
+
+func (m *BucketService) Get(ctx context.Context, id string) (r0 *govpsie.Bucket, r1 error) {
+	m.record("Get", []interface{}{ctx, id})
+	if m.GetFunc == nil {
+		r1 = notStubbed("BucketService.Get")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *BucketService) Create(ctx context.Context, createReq *govpsie.CreateBucketReq) (r0 error) {
+	m.record("Create", []interface{}{ctx, createReq})
+	if m.CreateFunc == nil {
+		r0 = notStubbed("BucketService.Create")
+		return
+	}
+	return m.CreateFunc(ctx, createReq)
+}
+
+func (m *BucketService) Delete(ctx context.Context, bucketId string, reason string, note string) (r0 error) {
+	m.record("Delete", []interface{}{ctx, bucketId, reason, note})
+	if m.DeleteFunc == nil {
+		r0 = notStubbed("BucketService.Delete")
+		return
+	}
+	return m.DeleteFunc(ctx, bucketId, reason, note)
+}
+
+func (m *BucketService) ToggleFileListing(ctx context.Context, bucketId string, fileListing bool) (r0 bool, r1 error) {
+	m.record("ToggleFileListing", []interface{}{ctx, bucketId, fileListing})
+	if m.ToggleFileListingFunc == nil {
+		r1 = notStubbed("BucketService.ToggleFileListing")
+		return
+	}
+	return m.ToggleFileListingFunc(ctx, bucketId, fileListing)
+}
+
+func (m *BucketService) CheckFileListingStatus(ctx context.Context, bucketId string) (r0 bool, r1 error) {
+	m.record("CheckFileListingStatus", []interface{}{ctx, bucketId})
+	if m.CheckFileListingStatusFunc == nil {
+		r1 = notStubbed("BucketService.CheckFileListingStatus")
+		return
+	}
+	return m.CheckFileListingStatusFunc(ctx, bucketId)
+}
+
+func (m *BucketService) GenerateKey(ctx context.Context, keyName string) (r0 error) {
+	m.record("GenerateKey", []interface{}{ctx, keyName})
+	if m.GenerateKeyFunc == nil {
+		r0 = notStubbed("BucketService.GenerateKey")
+		return
+	}
+	return m.GenerateKeyFunc(ctx, keyName)
+}
+
+func (m *BucketService) ListBucketKeys(ctx context.Context) (r0 []govpsie.BucketKey, r1 error) {
+	m.record("ListBucketKeys", []interface{}{ctx})
+	if m.ListBucketKeysFunc == nil {
+		r1 = notStubbed("BucketService.ListBucketKeys")
+		return
+	}
+	return m.ListBucketKeysFunc(ctx)
+}
+
+// DataCenterService is a configurable fake of govpsie.DataCenterService.
+type DataCenterService struct {
+	recorder
+
+	ListFunc             func(context.Context, *govpsie.ListOptions) ([]govpsie.DataCenter, error)
+	ListWithResponseFunc func(context.Context, *govpsie.ListOptions) ([]govpsie.DataCenter, *govpsie.Response, error)
+}
+
+var _ govpsie.DataCenterService = (*DataCenterService)(nil)
+
+func (m *DataCenterService) List(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.DataCenter, r1 error) {
+	m.record("List", []interface{}{ctx, options})
+	if m.ListFunc == nil {
+		r1 = notStubbed("DataCenterService.List")
+		return
+	}
+	return m.ListFunc(ctx, options)
+}
+
+func (m *DataCenterService) ListWithResponse(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.DataCenter, r1 *govpsie.Response, r2 error) {
+	m.record("ListWithResponse", []interface{}{ctx, options})
+	if m.ListWithResponseFunc == nil {
+		r2 = notStubbed("DataCenterService.ListWithResponse")
+		return
+	}
+	return m.ListWithResponseFunc(ctx, options)
+}
+
+// DomainService is a configurable fake of govpsie.DomainService.
+type DomainService struct {
+	recorder
+
+	ListDomainByProjectFunc     func(context.Context, *govpsie.ListOptions, string) ([]govpsie.Domain, error)
+	DnsRecordFunc               func(context.Context, string, *govpsie.DnsRecord) error
+	ListDomainsFunc             func(context.Context, *govpsie.ListOptions) ([]govpsie.Domain, error)
+	ListDomainsWithResponseFunc func(context.Context, *govpsie.ListOptions) ([]govpsie.Domain, *govpsie.Response, error)
+	ListAllDomainsFunc          func(context.Context) ([]govpsie.Domain, error)
+	ListDomainVpsiesFunc        func(context.Context, *govpsie.ListOptions) ([]govpsie.DomainVpsie, error)
+	CreateDomainFunc            func(context.Context, *govpsie.CreateDomainRequest) error
+	GetDomainByVpsieFunc        func(context.Context, string) ([]govpsie.Domain, error)
+	UpdateReverseFunc           func(context.Context, *govpsie.ReverseRequest) error
+	AddReverseFunc              func(context.Context, *govpsie.ReverseRequest) error
+	UpdateDomainFunc            func(context.Context, *govpsie.DnsRecord, string, string) error
+	DeleteReverseFunc           func(context.Context, string, string) error
+	CreateDnsRecordFunc         func(context.Context, govpsie.CreateDnsRecordReq) error
+	UpdateDnsRecordFunc         func(context.Context, *govpsie.UpdateDnsRecordReq) error
+	DeleteDomainFunc            func(context.Context, string, string, string) error
+	DeleteDnsRecordFunc         func(context.Context, string, *govpsie.Record) error
+	ListReversePTRRecordsFunc   func(context.Context) ([]govpsie.ReversePTR, error)
+}
+
+var _ govpsie.DomainService = (*DomainService)(nil)
+
+func (m *DomainService) ListDomainByProject(ctx context.Context, options *govpsie.ListOptions, projectIdentifier string) (r0 []govpsie.Domain, r1 error) {
+	m.record("ListDomainByProject", []interface{}{ctx, options, projectIdentifier})
+	if m.ListDomainByProjectFunc == nil {
+		r1 = notStubbed("DomainService.ListDomainByProject")
+		return
+	}
+	return m.ListDomainByProjectFunc(ctx, options, projectIdentifier)
+}
+
+func (m *DomainService) DnsRecord(ctx context.Context, domainIdentifier string, dnsRecord *govpsie.DnsRecord) (r0 error) {
+	m.record("DnsRecord", []interface{}{ctx, domainIdentifier, dnsRecord})
+	if m.DnsRecordFunc == nil {
+		r0 = notStubbed("DomainService.DnsRecord")
+		return
+	}
+	return m.DnsRecordFunc(ctx, domainIdentifier, dnsRecord)
+}
+
+func (m *DomainService) ListDomains(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.Domain, r1 error) {
+	m.record("ListDomains", []interface{}{ctx, options})
+	if m.ListDomainsFunc == nil {
+		r1 = notStubbed("DomainService.ListDomains")
+		return
+	}
+	return m.ListDomainsFunc(ctx, options)
+}
+
+func (m *DomainService) ListDomainsWithResponse(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.Domain, r1 *govpsie.Response, r2 error) {
+	m.record("ListDomainsWithResponse", []interface{}{ctx, options})
+	if m.ListDomainsWithResponseFunc == nil {
+		r2 = notStubbed("DomainService.ListDomainsWithResponse")
+		return
+	}
+	return m.ListDomainsWithResponseFunc(ctx, options)
+}
+
+func (m *DomainService) ListAllDomains(ctx context.Context) (r0 []govpsie.Domain, r1 error) {
+	m.record("ListAllDomains", []interface{}{ctx})
+	if m.ListAllDomainsFunc == nil {
+		r1 = notStubbed("DomainService.ListAllDomains")
+		return
+	}
+	return m.ListAllDomainsFunc(ctx)
+}
+
+func (m *DomainService) ListDomainVpsies(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.DomainVpsie, r1 error) {
+	m.record("ListDomainVpsies", []interface{}{ctx, options})
+	if m.ListDomainVpsiesFunc == nil {
+		r1 = notStubbed("DomainService.ListDomainVpsies")
+		return
+	}
+	return m.ListDomainVpsiesFunc(ctx, options)
+}
+
+func (m *DomainService) CreateDomain(ctx context.Context, createReq *govpsie.CreateDomainRequest) (r0 error) {
+	m.record("CreateDomain", []interface{}{ctx, createReq})
+	if m.CreateDomainFunc == nil {
+		r0 = notStubbed("DomainService.CreateDomain")
+		return
+	}
+	return m.CreateDomainFunc(ctx, createReq)
+}
+
+func (m *DomainService) GetDomainByVpsie(ctx context.Context, domainIdentifier string) (r0 []govpsie.Domain, r1 error) {
+	m.record("GetDomainByVpsie", []interface{}{ctx, domainIdentifier})
+	if m.GetDomainByVpsieFunc == nil {
+		r1 = notStubbed("DomainService.GetDomainByVpsie")
+		return
+	}
+	return m.GetDomainByVpsieFunc(ctx, domainIdentifier)
+}
+
+func (m *DomainService) UpdateReverse(ctx context.Context, reverseReq *govpsie.ReverseRequest) (r0 error) {
+	m.record("UpdateReverse", []interface{}{ctx, reverseReq})
+	if m.UpdateReverseFunc == nil {
+		r0 = notStubbed("DomainService.UpdateReverse")
+		return
+	}
+	return m.UpdateReverseFunc(ctx, reverseReq)
+}
+
+func (m *DomainService) AddReverse(ctx context.Context, reverseReq *govpsie.ReverseRequest) (r0 error) {
+	m.record("AddReverse", []interface{}{ctx, reverseReq})
+	if m.AddReverseFunc == nil {
+		r0 = notStubbed("DomainService.AddReverse")
+		return
+	}
+	return m.AddReverseFunc(ctx, reverseReq)
+}
+
+func (m *DomainService) UpdateDomain(ctx context.Context, dnsRecord *govpsie.DnsRecord, domainIdentifier string, vmIdentifier string) (r0 error) {
+	m.record("UpdateDomain", []interface{}{ctx, dnsRecord, domainIdentifier, vmIdentifier})
+	if m.UpdateDomainFunc == nil {
+		r0 = notStubbed("DomainService.UpdateDomain")
+		return
+	}
+	return m.UpdateDomainFunc(ctx, dnsRecord, domainIdentifier, vmIdentifier)
+}
+
+func (m *DomainService) DeleteReverse(ctx context.Context, ip string, vmIdentifier string) (r0 error) {
+	m.record("DeleteReverse", []interface{}{ctx, ip, vmIdentifier})
+	if m.DeleteReverseFunc == nil {
+		r0 = notStubbed("DomainService.DeleteReverse")
+		return
+	}
+	return m.DeleteReverseFunc(ctx, ip, vmIdentifier)
+}
+
+func (m *DomainService) CreateDnsRecord(ctx context.Context, createReq govpsie.CreateDnsRecordReq) (r0 error) {
+	m.record("CreateDnsRecord", []interface{}{ctx, createReq})
+	if m.CreateDnsRecordFunc == nil {
+		r0 = notStubbed("DomainService.CreateDnsRecord")
+		return
+	}
+	return m.CreateDnsRecordFunc(ctx, createReq)
+}
+
+func (m *DomainService) UpdateDnsRecord(ctx context.Context, updateReq *govpsie.UpdateDnsRecordReq) (r0 error) {
+	m.record("UpdateDnsRecord", []interface{}{ctx, updateReq})
+	if m.UpdateDnsRecordFunc == nil {
+		r0 = notStubbed("DomainService.UpdateDnsRecord")
+		return
+	}
+	return m.UpdateDnsRecordFunc(ctx, updateReq)
+}
+
+func (m *DomainService) DeleteDomain(ctx context.Context, domainIdentifier string, reason string, note string) (r0 error) {
+	m.record("DeleteDomain", []interface{}{ctx, domainIdentifier, reason, note})
+	if m.DeleteDomainFunc == nil {
+		r0 = notStubbed("DomainService.DeleteDomain")
+		return
+	}
+	return m.DeleteDomainFunc(ctx, domainIdentifier, reason, note)
+}
+
+func (m *DomainService) DeleteDnsRecord(ctx context.Context, domainIdentifier string, record *govpsie.Record) (r0 error) {
+	m.record("DeleteDnsRecord", []interface{}{ctx, domainIdentifier, record})
+	if m.DeleteDnsRecordFunc == nil {
+		r0 = notStubbed("DomainService.DeleteDnsRecord")
+		return
+	}
+	return m.DeleteDnsRecordFunc(ctx, domainIdentifier, record)
+}
+
+func (m *DomainService) ListReversePTRRecords(ctx context.Context) (r0 []govpsie.ReversePTR, r1 error) {
+	m.record("ListReversePTRRecords", []interface{}{ctx})
+	if m.ListReversePTRRecordsFunc == nil {
+		r1 = notStubbed("DomainService.ListReversePTRRecords")
+		return
+	}
+	return m.ListReversePTRRecordsFunc(ctx)
+}
+
+// FipService is a configurable fake of govpsie.FipService.
+type FipService struct {
+	recorder
+
+	AssignFloatingIPFunc   func(context.Context) error
+	UnassignFloatingIPFunc func(context.Context, string) error
+	CreateFloatingIPFunc   func(context.Context, string, string, string) error
+}
+
+var _ govpsie.FipService = (*FipService)(nil)
+
+func (m *FipService) AssignFloatingIP(a0 context.Context) (r0 error) {
+	m.record("AssignFloatingIP", []interface{}{a0})
+	if m.AssignFloatingIPFunc == nil {
+		r0 = notStubbed("FipService.AssignFloatingIP")
+		return
+	}
+	return m.AssignFloatingIPFunc(a0)
+}
+
+func (m *FipService) UnassignFloatingIP(ctx context.Context, id string) (r0 error) {
+	m.record("UnassignFloatingIP", []interface{}{ctx, id})
+	if m.UnassignFloatingIPFunc == nil {
+		r0 = notStubbed("FipService.UnassignFloatingIP")
+		return
+	}
+	return m.UnassignFloatingIPFunc(ctx, id)
+}
+
+func (m *FipService) CreateFloatingIP(ctx context.Context, vmIdentifier string, dcIdentifier string, ipType string) (r0 error) {
+	m.record("CreateFloatingIP", []interface{}{ctx, vmIdentifier, dcIdentifier, ipType})
+	if m.CreateFloatingIPFunc == nil {
+		r0 = notStubbed("FipService.CreateFloatingIP")
+		return
+	}
+	return m.CreateFloatingIPFunc(ctx, vmIdentifier, dcIdentifier, ipType)
+}
+
+// FirewallGroupService is a configurable fake of govpsie.FirewallGroupService.
+type FirewallGroupService struct {
+	recorder
+
+	CreateFunc                      func(context.Context, string, []govpsie.FirewallUpdateReq) error
+	ListFunc                        func(context.Context, *govpsie.ListOptions) ([]govpsie.FirewallGroupListData, error)
+	ListWithResponseFunc            func(context.Context, *govpsie.ListOptions) ([]govpsie.FirewallGroupListData, *govpsie.Response, error)
+	GetFunc                         func(context.Context, string) (*govpsie.FirewallGroupDetailData, error)
+	DeleteFunc                      func(context.Context, string) error
+	UpdateFunc                      func(context.Context, *govpsie.FirewallUpdateReq, string) error
+	AssignToVpsieFunc               func(context.Context, string, string) error
+	DetachFromVpsieFunc             func(context.Context, string, string) error
+	AttachToVpsieFunc               func(context.Context, string, string) error
+	DeleteFirewallGroupOfServerFunc func(context.Context, string, string) error
+	GetFirewallGroupFunc            func(context.Context, string) (*govpsie.FirewallGroupDetailData, error)
+}
+
+var _ govpsie.FirewallGroupService = (*FirewallGroupService)(nil)
+
+func (m *FirewallGroupService) Create(ctx context.Context, groupName string, firewallUpdateReq []govpsie.FirewallUpdateReq) (r0 error) {
+	m.record("Create", []interface{}{ctx, groupName, firewallUpdateReq})
+	if m.CreateFunc == nil {
+		r0 = notStubbed("FirewallGroupService.Create")
+		return
+	}
+	return m.CreateFunc(ctx, groupName, firewallUpdateReq)
+}
+
+func (m *FirewallGroupService) List(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.FirewallGroupListData, r1 error) {
+	m.record("List", []interface{}{ctx, options})
+	if m.ListFunc == nil {
+		r1 = notStubbed("FirewallGroupService.List")
+		return
+	}
+	return m.ListFunc(ctx, options)
+}
+
+func (m *FirewallGroupService) ListWithResponse(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.FirewallGroupListData, r1 *govpsie.Response, r2 error) {
+	m.record("ListWithResponse", []interface{}{ctx, options})
+	if m.ListWithResponseFunc == nil {
+		r2 = notStubbed("FirewallGroupService.ListWithResponse")
+		return
+	}
+	return m.ListWithResponseFunc(ctx, options)
+}
+
+func (m *FirewallGroupService) Get(ctx context.Context, fwGroupId string) (r0 *govpsie.FirewallGroupDetailData, r1 error) {
+	m.record("Get", []interface{}{ctx, fwGroupId})
+	if m.GetFunc == nil {
+		r1 = notStubbed("FirewallGroupService.Get")
+		return
+	}
+	return m.GetFunc(ctx, fwGroupId)
+}
+
+func (m *FirewallGroupService) Delete(ctx context.Context, fwGroupId string) (r0 error) {
+	m.record("Delete", []interface{}{ctx, fwGroupId})
+	if m.DeleteFunc == nil {
+		r0 = notStubbed("FirewallGroupService.Delete")
+		return
+	}
+	return m.DeleteFunc(ctx, fwGroupId)
+}
+
+func (m *FirewallGroupService) Update(ctx context.Context, fwGroupReq *govpsie.FirewallUpdateReq, fwGroupId string) (r0 error) {
+	m.record("Update", []interface{}{ctx, fwGroupReq, fwGroupId})
+	if m.UpdateFunc == nil {
+		r0 = notStubbed("FirewallGroupService.Update")
+		return
+	}
+	return m.UpdateFunc(ctx, fwGroupReq, fwGroupId)
+}
+
+func (m *FirewallGroupService) AssignToVpsie(ctx context.Context, groupId string, vmId string) (r0 error) {
+	m.record("AssignToVpsie", []interface{}{ctx, groupId, vmId})
+	if m.AssignToVpsieFunc == nil {
+		r0 = notStubbed("FirewallGroupService.AssignToVpsie")
+		return
+	}
+	return m.AssignToVpsieFunc(ctx, groupId, vmId)
+}
+
+func (m *FirewallGroupService) DetachFromVpsie(ctx context.Context, groupId string, vmId string) (r0 error) {
+	m.record("DetachFromVpsie", []interface{}{ctx, groupId, vmId})
+	if m.DetachFromVpsieFunc == nil {
+		r0 = notStubbed("FirewallGroupService.DetachFromVpsie")
+		return
+	}
+	return m.DetachFromVpsieFunc(ctx, groupId, vmId)
+}
+
+func (m *FirewallGroupService) AttachToVpsie(ctx context.Context, groupId string, vmId string) (r0 error) {
+	m.record("AttachToVpsie", []interface{}{ctx, groupId, vmId})
+	if m.AttachToVpsieFunc == nil {
+		r0 = notStubbed("FirewallGroupService.AttachToVpsie")
+		return
+	}
+	return m.AttachToVpsieFunc(ctx, groupId, vmId)
+}
+
+func (m *FirewallGroupService) DeleteFirewallGroupOfServer(ctx context.Context, groupId string, vmId string) (r0 error) {
+	m.record("DeleteFirewallGroupOfServer", []interface{}{ctx, groupId, vmId})
+	if m.DeleteFirewallGroupOfServerFunc == nil {
+		r0 = notStubbed("FirewallGroupService.DeleteFirewallGroupOfServer")
+		return
+	}
+	return m.DeleteFirewallGroupOfServerFunc(ctx, groupId, vmId)
+}
+
+func (m *FirewallGroupService) GetFirewallGroup(ctx context.Context, fwGroupId string) (r0 *govpsie.FirewallGroupDetailData, r1 error) {
+	m.record("GetFirewallGroup", []interface{}{ctx, fwGroupId})
+	if m.GetFirewallGroupFunc == nil {
+		r1 = notStubbed("FirewallGroupService.GetFirewallGroup")
+		return
+	}
+	return m.GetFirewallGroupFunc(ctx, fwGroupId)
+}
+
+// FirewallService is a configurable fake of govpsie.FirewallService.
+type FirewallService struct {
+	recorder
+
+	ListMacrosFunc    func(context.Context, *govpsie.ListOptions) ([]govpsie.Macros, error)
+	RemoveGroupVmFunc func(context.Context, string, string) error
+}
+
+var _ govpsie.FirewallService = (*FirewallService)(nil)
+
+func (m *FirewallService) ListMacros(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.Macros, r1 error) {
+	m.record("ListMacros", []interface{}{ctx, options})
+	if m.ListMacrosFunc == nil {
+		r1 = notStubbed("FirewallService.ListMacros")
+		return
+	}
+	return m.ListMacrosFunc(ctx, options)
+}
+
+func (m *FirewallService) RemoveGroupVm(ctx context.Context, vmId string, groupId string) (r0 error) {
+	m.record("RemoveGroupVm", []interface{}{ctx, vmId, groupId})
+	if m.RemoveGroupVmFunc == nil {
+		r0 = notStubbed("FirewallService.RemoveGroupVm")
+		return
+	}
+	return m.RemoveGroupVmFunc(ctx, vmId, groupId)
+}
+
+// GatewayService is a configurable fake of govpsie.GatewayService.
+type GatewayService struct {
+	recorder
+
+	ListFunc             func(context.Context, *govpsie.ListOptions) ([]govpsie.Gateway, error)
+	ListWithResponseFunc func(context.Context, *govpsie.ListOptions) ([]govpsie.Gateway, *govpsie.Response, error)
+	DeleteFunc           func(context.Context, int) error
+	CreateFunc           func(context.Context, *govpsie.CreateGatewayReq) error
+	GetFunc              func(context.Context, int64) (*govpsie.Gateway, error)
+	AttachVMFunc         func(context.Context, int64, []string, int64) error
+	DetachVMFunc         func(context.Context, int64, []int64) error
+}
+
+var _ govpsie.GatewayService = (*GatewayService)(nil)
+
+func (m *GatewayService) List(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.Gateway, r1 error) {
+	m.record("List", []interface{}{ctx, options})
+	if m.ListFunc == nil {
+		r1 = notStubbed("GatewayService.List")
+		return
+	}
+	return m.ListFunc(ctx, options)
+}
+
+func (m *GatewayService) ListWithResponse(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.Gateway, r1 *govpsie.Response, r2 error) {
+	m.record("ListWithResponse", []interface{}{ctx, options})
+	if m.ListWithResponseFunc == nil {
+		r2 = notStubbed("GatewayService.ListWithResponse")
+		return
+	}
+	return m.ListWithResponseFunc(ctx, options)
+}
+
+func (m *GatewayService) Delete(ctx context.Context, ipId int) (r0 error) {
+	m.record("Delete", []interface{}{ctx, ipId})
+	if m.DeleteFunc == nil {
+		r0 = notStubbed("GatewayService.Delete")
+		return
+	}
+	return m.DeleteFunc(ctx, ipId)
+}
+
+func (m *GatewayService) Create(ctx context.Context, createReq *govpsie.CreateGatewayReq) (r0 error) {
+	m.record("Create", []interface{}{ctx, createReq})
+	if m.CreateFunc == nil {
+		r0 = notStubbed("GatewayService.Create")
+		return
+	}
+	return m.CreateFunc(ctx, createReq)
+}
+
+func (m *GatewayService) Get(ctx context.Context, id int64) (r0 *govpsie.Gateway, r1 error) {
+	m.record("Get", []interface{}{ctx, id})
+	if m.GetFunc == nil {
+		r1 = notStubbed("GatewayService.Get")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *GatewayService) AttachVM(ctx context.Context, id int64, vms []string, ignoreLegacyVms int64) (r0 error) {
+	m.record("AttachVM", []interface{}{ctx, id, vms, ignoreLegacyVms})
+	if m.AttachVMFunc == nil {
+		r0 = notStubbed("GatewayService.AttachVM")
+		return
+	}
+	return m.AttachVMFunc(ctx, id, vms, ignoreLegacyVms)
+}
+
+func (m *GatewayService) DetachVM(ctx context.Context, id int64, mapping_id []int64) (r0 error) {
+	m.record("DetachVM", []interface{}{ctx, id, mapping_id})
+	if m.DetachVMFunc == nil {
+		r0 = notStubbed("GatewayService.DetachVM")
+		return
+	}
+	return m.DetachVMFunc(ctx, id, mapping_id)
+}
+
+// IPsService is a configurable fake of govpsie.IPsService.
+type IPsService struct {
+	recorder
+
+	ListPrivateIPsFunc         func(context.Context, *govpsie.ListOptions) ([]govpsie.IP, error)
+	ListPublicIPsFunc          func(context.Context, *govpsie.ListOptions) ([]govpsie.IP, error)
+	ListAllIPsFunc             func(context.Context, *govpsie.ListOptions) ([]govpsie.IP, error)
+	ListAllIPsWithResponseFunc func(context.Context, *govpsie.ListOptions) ([]govpsie.IP, *govpsie.Response, error)
+	DeleteIPFunc               func(context.Context, string, string) error
+	CreateIpsFunc              func(context.Context, string, string) error
+}
+
+var _ govpsie.IPsService = (*IPsService)(nil)
+
+func (m *IPsService) ListPrivateIPs(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.IP, r1 error) {
+	m.record("ListPrivateIPs", []interface{}{ctx, options})
+	if m.ListPrivateIPsFunc == nil {
+		r1 = notStubbed("IPsService.ListPrivateIPs")
+		return
+	}
+	return m.ListPrivateIPsFunc(ctx, options)
+}
+
+func (m *IPsService) ListPublicIPs(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.IP, r1 error) {
+	m.record("ListPublicIPs", []interface{}{ctx, options})
+	if m.ListPublicIPsFunc == nil {
+		r1 = notStubbed("IPsService.ListPublicIPs")
+		return
+	}
+	return m.ListPublicIPsFunc(ctx, options)
+}
+
+func (m *IPsService) ListAllIPs(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.IP, r1 error) {
+	m.record("ListAllIPs", []interface{}{ctx, options})
+	if m.ListAllIPsFunc == nil {
+		r1 = notStubbed("IPsService.ListAllIPs")
+		return
+	}
+	return m.ListAllIPsFunc(ctx, options)
+}
+
+func (m *IPsService) ListAllIPsWithResponse(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.IP, r1 *govpsie.Response, r2 error) {
+	m.record("ListAllIPsWithResponse", []interface{}{ctx, options})
+	if m.ListAllIPsWithResponseFunc == nil {
+		r2 = notStubbed("IPsService.ListAllIPsWithResponse")
+		return
+	}
+	return m.ListAllIPsWithResponseFunc(ctx, options)
+}
+
+func (m *IPsService) DeleteIP(ctx context.Context, ip string, vmIdentifier string) (r0 error) {
+	m.record("DeleteIP", []interface{}{ctx, ip, vmIdentifier})
+	if m.DeleteIPFunc == nil {
+		r0 = notStubbed("IPsService.DeleteIP")
+		return
+	}
+	return m.DeleteIPFunc(ctx, ip, vmIdentifier)
+}
+
+func (m *IPsService) CreateIps(ctx context.Context, ipType string, vmIdentifier string) (r0 error) {
+	m.record("CreateIps", []interface{}{ctx, ipType, vmIdentifier})
+	if m.CreateIpsFunc == nil {
+		r0 = notStubbed("IPsService.CreateIps")
+		return
+	}
+	return m.CreateIpsFunc(ctx, ipType, vmIdentifier)
+}
+
+// ImagesService is a configurable fake of govpsie.ImagesService.
+type ImagesService struct {
+	recorder
+
+	DeleteImageFunc         func(context.Context, string) error
+	ListFunc                func(context.Context, *govpsie.ListOptions) ([]govpsie.CustomImage, error)
+	CreateImagesFunc        func(context.Context, string, string, string) error
+	CreateServerByImageFunc func(context.Context, *govpsie.CreateServerRequest) error
+	GetImageFunc            func(context.Context, string) (*govpsie.CustomImage, error)
+}
+
+var _ govpsie.ImagesService = (*ImagesService)(nil)
+
+func (m *ImagesService) DeleteImage(ctx context.Context, imageIdentifier string) (r0 error) {
+	m.record("DeleteImage", []interface{}{ctx, imageIdentifier})
+	if m.DeleteImageFunc == nil {
+		r0 = notStubbed("ImagesService.DeleteImage")
+		return
+	}
+	return m.DeleteImageFunc(ctx, imageIdentifier)
+}
+
+func (m *ImagesService) List(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.CustomImage, r1 error) {
+	m.record("List", []interface{}{ctx, options})
+	if m.ListFunc == nil {
+		r1 = notStubbed("ImagesService.List")
+		return
+	}
+	return m.ListFunc(ctx, options)
+}
+
+func (m *ImagesService) CreateImages(ctx context.Context, dcIdentifier string, imageName string, imageUrl string) (r0 error) {
+	m.record("CreateImages", []interface{}{ctx, dcIdentifier, imageName, imageUrl})
+	if m.CreateImagesFunc == nil {
+		r0 = notStubbed("ImagesService.CreateImages")
+		return
+	}
+	return m.CreateImagesFunc(ctx, dcIdentifier, imageName, imageUrl)
+}
+
+func (m *ImagesService) CreateServerByImage(ctx context.Context, createServerReq *govpsie.CreateServerRequest) (r0 error) {
+	m.record("CreateServerByImage", []interface{}{ctx, createServerReq})
+	if m.CreateServerByImageFunc == nil {
+		r0 = notStubbed("ImagesService.CreateServerByImage")
+		return
+	}
+	return m.CreateServerByImageFunc(ctx, createServerReq)
+}
+
+func (m *ImagesService) GetImage(ctx context.Context, imageIdentifier string) (r0 *govpsie.CustomImage, r1 error) {
+	m.record("GetImage", []interface{}{ctx, imageIdentifier})
+	if m.GetImageFunc == nil {
+		r1 = notStubbed("ImagesService.GetImage")
+		return
+	}
+	return m.GetImageFunc(ctx, imageIdentifier)
+}
+
+// K8sService is a configurable fake of govpsie.K8sService.
+type K8sService struct {
+	recorder
+
+	ListFunc              func(context.Context, *govpsie.ListOptions) ([]govpsie.ListK8s, error)
+	DeleteFunc            func(context.Context, string, string, string) error
+	CreateFunc            func(context.Context, *govpsie.CreateK8sReq) error
+	GetFunc               func(context.Context, string) (*govpsie.K8s, error)
+	AddSlaveFunc          func(context.Context, string) error
+	RemoveSlaveFunc       func(context.Context, string) error
+	ListK8sGroupsFunc     func(context.Context, string) ([]govpsie.K8sGroup, error)
+	AddNodeFunc           func(context.Context, string, string, int) error
+	RemoveNodeFunc        func(context.Context, string, string, int) error
+	CreateK8sGroupFunc    func(context.Context, *govpsie.CreateK8sGroupReq) error
+	DeleteK8sGroupFunc    func(context.Context, string, string, string) error
+	UpgradeK8sVersionFunc func(context.Context, string) error
+	PatchK8sVersionFunc   func(context.Context, string, string) error
+}
+
+var _ govpsie.K8sService = (*K8sService)(nil)
+
+func (m *K8sService) List(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.ListK8s, r1 error) {
+	m.record("List", []interface{}{ctx, options})
+	if m.ListFunc == nil {
+		r1 = notStubbed("K8sService.List")
+		return
+	}
+	return m.ListFunc(ctx, options)
+}
+
+func (m *K8sService) Delete(ctx context.Context, identifier string, reason string, note string) (r0 error) {
+	m.record("Delete", []interface{}{ctx, identifier, reason, note})
+	if m.DeleteFunc == nil {
+		r0 = notStubbed("K8sService.Delete")
+		return
+	}
+	return m.DeleteFunc(ctx, identifier, reason, note)
+}
+
+func (m *K8sService) Create(ctx context.Context, createReq *govpsie.CreateK8sReq) (r0 error) {
+	m.record("Create", []interface{}{ctx, createReq})
+	if m.CreateFunc == nil {
+		r0 = notStubbed("K8sService.Create")
+		return
+	}
+	return m.CreateFunc(ctx, createReq)
+}
+
+func (m *K8sService) Get(ctx context.Context, identifier string) (r0 *govpsie.K8s, r1 error) {
+	m.record("Get", []interface{}{ctx, identifier})
+	if m.GetFunc == nil {
+		r1 = notStubbed("K8sService.Get")
+		return
+	}
+	return m.GetFunc(ctx, identifier)
+}
+
+func (m *K8sService) AddSlave(ctx context.Context, identifier string) (r0 error) {
+	m.record("AddSlave", []interface{}{ctx, identifier})
+	if m.AddSlaveFunc == nil {
+		r0 = notStubbed("K8sService.AddSlave")
+		return
+	}
+	return m.AddSlaveFunc(ctx, identifier)
+}
+
+func (m *K8sService) RemoveSlave(ctx context.Context, identifier string) (r0 error) {
+	m.record("RemoveSlave", []interface{}{ctx, identifier})
+	if m.RemoveSlaveFunc == nil {
+		r0 = notStubbed("K8sService.RemoveSlave")
+		return
+	}
+	return m.RemoveSlaveFunc(ctx, identifier)
+}
+
+func (m *K8sService) ListK8sGroups(ctx context.Context, identifier string) (r0 []govpsie.K8sGroup, r1 error) {
+	m.record("ListK8sGroups", []interface{}{ctx, identifier})
+	if m.ListK8sGroupsFunc == nil {
+		r1 = notStubbed("K8sService.ListK8sGroups")
+		return
+	}
+	return m.ListK8sGroupsFunc(ctx, identifier)
+}
+
+func (m *K8sService) AddNode(ctx context.Context, identifier string, nodeType string, groupId int) (r0 error) {
+	m.record("AddNode", []interface{}{ctx, identifier, nodeType, groupId})
+	if m.AddNodeFunc == nil {
+		r0 = notStubbed("K8sService.AddNode")
+		return
+	}
+	return m.AddNodeFunc(ctx, identifier, nodeType, groupId)
+}
+
+func (m *K8sService) RemoveNode(ctx context.Context, identifier string, nodeType string, groupId int) (r0 error) {
+	m.record("RemoveNode", []interface{}{ctx, identifier, nodeType, groupId})
+	if m.RemoveNodeFunc == nil {
+		r0 = notStubbed("K8sService.RemoveNode")
+		return
+	}
+	return m.RemoveNodeFunc(ctx, identifier, nodeType, groupId)
+}
+
+func (m *K8sService) CreateK8sGroup(ctx context.Context, createReq *govpsie.CreateK8sGroupReq) (r0 error) {
+	m.record("CreateK8sGroup", []interface{}{ctx, createReq})
+	if m.CreateK8sGroupFunc == nil {
+		r0 = notStubbed("K8sService.CreateK8sGroup")
+		return
+	}
+	return m.CreateK8sGroupFunc(ctx, createReq)
+}
+
+func (m *K8sService) DeleteK8sGroup(ctx context.Context, groupId string, reason string, note string) (r0 error) {
+	m.record("DeleteK8sGroup", []interface{}{ctx, groupId, reason, note})
+	if m.DeleteK8sGroupFunc == nil {
+		r0 = notStubbed("K8sService.DeleteK8sGroup")
+		return
+	}
+	return m.DeleteK8sGroupFunc(ctx, groupId, reason, note)
+}
+
+func (m *K8sService) UpgradeK8sVersion(ctx context.Context, identifier string) (r0 error) {
+	m.record("UpgradeK8sVersion", []interface{}{ctx, identifier})
+	if m.UpgradeK8sVersionFunc == nil {
+		r0 = notStubbed("K8sService.UpgradeK8sVersion")
+		return
+	}
+	return m.UpgradeK8sVersionFunc(ctx, identifier)
+}
+
+func (m *K8sService) PatchK8sVersion(ctx context.Context, identifier string, processId string) (r0 error) {
+	m.record("PatchK8sVersion", []interface{}{ctx, identifier, processId})
+	if m.PatchK8sVersionFunc == nil {
+		r0 = notStubbed("K8sService.PatchK8sVersion")
+		return
+	}
+	return m.PatchK8sVersionFunc(ctx, identifier, processId)
+}
+
+// LBsService is a configurable fake of govpsie.LBsService.
+type LBsService struct {
+	recorder
+
+	ListLBsFunc             func(context.Context, *govpsie.ListOptions) ([]govpsie.LB, error)
+	ListLBsWithResponseFunc func(context.Context, *govpsie.ListOptions) ([]govpsie.LB, *govpsie.Response, error)
+	ListLBDataCentersFunc   func(context.Context, *govpsie.ListOptions) ([]govpsie.LBDataCenter, error)
+	ListOffersFunc          func(context.Context, string) ([]govpsie.LBOffers, error)
+	GetLBFunc               func(context.Context, string) (*govpsie.LBDetails, error)
+	CreateLBFunc            func(context.Context, *govpsie.CreateLBReq) error
+	DeleteLBFunc            func(context.Context, string, string, string) error
+	AddLBRuleFunc           func(context.Context, *govpsie.AddRuleReq) error
+	DeleteLBRuleFunc        func(context.Context, string) error
+	AddLBDomainFunc         func(context.Context, *govpsie.DomainAddReq) error
+	ReplaceDomainFunc       func(context.Context, string, string) error
+	UpdateDomainBackendFunc func(context.Context, string, []govpsie.Backend) error
+	UpdateLBDomainFunc      func(context.Context, *govpsie.DomainUpdateReq) error
+	UpdateLBRulesFunc       func(context.Context, *govpsie.RuleUpdateReq) error
+	DeleteLBDomainFunc      func(context.Context, string) error
+	DeleteLBBackendFunc     func(context.Context, string) error
+	ListPendingLBsFunc      func(context.Context) ([]govpsie.PendingLB, error)
+}
+
+var _ govpsie.LBsService = (*LBsService)(nil)
+
+func (m *LBsService) ListLBs(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.LB, r1 error) {
+	m.record("ListLBs", []interface{}{ctx, options})
+	if m.ListLBsFunc == nil {
+		r1 = notStubbed("LBsService.ListLBs")
+		return
+	}
+	return m.ListLBsFunc(ctx, options)
+}
+
+func (m *LBsService) ListLBsWithResponse(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.LB, r1 *govpsie.Response, r2 error) {
+	m.record("ListLBsWithResponse", []interface{}{ctx, options})
+	if m.ListLBsWithResponseFunc == nil {
+		r2 = notStubbed("LBsService.ListLBsWithResponse")
+		return
+	}
+	return m.ListLBsWithResponseFunc(ctx, options)
+}
+
+func (m *LBsService) ListLBDataCenters(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.LBDataCenter, r1 error) {
+	m.record("ListLBDataCenters", []interface{}{ctx, options})
+	if m.ListLBDataCentersFunc == nil {
+		r1 = notStubbed("LBsService.ListLBDataCenters")
+		return
+	}
+	return m.ListLBDataCentersFunc(ctx, options)
+}
+
+func (m *LBsService) ListOffers(ctx context.Context, dcIdentifier string) (r0 []govpsie.LBOffers, r1 error) {
+	m.record("ListOffers", []interface{}{ctx, dcIdentifier})
+	if m.ListOffersFunc == nil {
+		r1 = notStubbed("LBsService.ListOffers")
+		return
+	}
+	return m.ListOffersFunc(ctx, dcIdentifier)
+}
+
+func (m *LBsService) GetLB(ctx context.Context, lbID string) (r0 *govpsie.LBDetails, r1 error) {
+	m.record("GetLB", []interface{}{ctx, lbID})
+	if m.GetLBFunc == nil {
+		r1 = notStubbed("LBsService.GetLB")
+		return
+	}
+	return m.GetLBFunc(ctx, lbID)
+}
+
+func (m *LBsService) CreateLB(ctx context.Context, createLBReq *govpsie.CreateLBReq) (r0 error) {
+	m.record("CreateLB", []interface{}{ctx, createLBReq})
+	if m.CreateLBFunc == nil {
+		r0 = notStubbed("LBsService.CreateLB")
+		return
+	}
+	return m.CreateLBFunc(ctx, createLBReq)
+}
+
+func (m *LBsService) DeleteLB(ctx context.Context, lbID string, reason string, note string) (r0 error) {
+	m.record("DeleteLB", []interface{}{ctx, lbID, reason, note})
+	if m.DeleteLBFunc == nil {
+		r0 = notStubbed("LBsService.DeleteLB")
+		return
+	}
+	return m.DeleteLBFunc(ctx, lbID, reason, note)
+}
+
+func (m *LBsService) AddLBRule(ctx context.Context, addRuleReq *govpsie.AddRuleReq) (r0 error) {
+	m.record("AddLBRule", []interface{}{ctx, addRuleReq})
+	if m.AddLBRuleFunc == nil {
+		r0 = notStubbed("LBsService.AddLBRule")
+		return
+	}
+	return m.AddLBRuleFunc(ctx, addRuleReq)
+}
+
+func (m *LBsService) DeleteLBRule(ctx context.Context, ruleID string) (r0 error) {
+	m.record("DeleteLBRule", []interface{}{ctx, ruleID})
+	if m.DeleteLBRuleFunc == nil {
+		r0 = notStubbed("LBsService.DeleteLBRule")
+		return
+	}
+	return m.DeleteLBRuleFunc(ctx, ruleID)
+}
+
+func (m *LBsService) AddLBDomain(ctx context.Context, domainAddReq *govpsie.DomainAddReq) (r0 error) {
+	m.record("AddLBDomain", []interface{}{ctx, domainAddReq})
+	if m.AddLBDomainFunc == nil {
+		r0 = notStubbed("LBsService.AddLBDomain")
+		return
+	}
+	return m.AddLBDomainFunc(ctx, domainAddReq)
+}
+
+func (m *LBsService) ReplaceDomain(ctx context.Context, domainId string, newDomainId string) (r0 error) {
+	m.record("ReplaceDomain", []interface{}{ctx, domainId, newDomainId})
+	if m.ReplaceDomainFunc == nil {
+		r0 = notStubbed("LBsService.ReplaceDomain")
+		return
+	}
+	return m.ReplaceDomainFunc(ctx, domainId, newDomainId)
+}
+
+func (m *LBsService) UpdateDomainBackend(ctx context.Context, domainId string, backends []govpsie.Backend) (r0 error) {
+	m.record("UpdateDomainBackend", []interface{}{ctx, domainId, backends})
+	if m.UpdateDomainBackendFunc == nil {
+		r0 = notStubbed("LBsService.UpdateDomainBackend")
+		return
+	}
+	return m.UpdateDomainBackendFunc(ctx, domainId, backends)
+}
+
+func (m *LBsService) UpdateLBDomain(ctx context.Context, domainUpdateReq *govpsie.DomainUpdateReq) (r0 error) {
+	m.record("UpdateLBDomain", []interface{}{ctx, domainUpdateReq})
+	if m.UpdateLBDomainFunc == nil {
+		r0 = notStubbed("LBsService.UpdateLBDomain")
+		return
+	}
+	return m.UpdateLBDomainFunc(ctx, domainUpdateReq)
+}
+
+func (m *LBsService) UpdateLBRules(ctx context.Context, ruleUpdateReq *govpsie.RuleUpdateReq) (r0 error) {
+	m.record("UpdateLBRules", []interface{}{ctx, ruleUpdateReq})
+	if m.UpdateLBRulesFunc == nil {
+		r0 = notStubbed("LBsService.UpdateLBRules")
+		return
+	}
+	return m.UpdateLBRulesFunc(ctx, ruleUpdateReq)
+}
+
+func (m *LBsService) DeleteLBDomain(ctx context.Context, domainID string) (r0 error) {
+	m.record("DeleteLBDomain", []interface{}{ctx, domainID})
+	if m.DeleteLBDomainFunc == nil {
+		r0 = notStubbed("LBsService.DeleteLBDomain")
+		return
+	}
+	return m.DeleteLBDomainFunc(ctx, domainID)
+}
+
+func (m *LBsService) DeleteLBBackend(ctx context.Context, lbBackendID string) (r0 error) {
+	m.record("DeleteLBBackend", []interface{}{ctx, lbBackendID})
+	if m.DeleteLBBackendFunc == nil {
+		r0 = notStubbed("LBsService.DeleteLBBackend")
+		return
+	}
+	return m.DeleteLBBackendFunc(ctx, lbBackendID)
+}
+
+func (m *LBsService) ListPendingLBs(ctx context.Context) (r0 []govpsie.PendingLB, r1 error) {
+	m.record("ListPendingLBs", []interface{}{ctx})
+	if m.ListPendingLBsFunc == nil {
+		r1 = notStubbed("LBsService.ListPendingLBs")
+		return
+	}
+	return m.ListPendingLBsFunc(ctx)
+}
+
+// LogsService is a configurable fake of govpsie.LogsService.
+type LogsService struct {
+	recorder
+
+	ListActivityLogsFunc             func(context.Context, *govpsie.ListOptions) ([]govpsie.ActivityLog, error)
+	ListActivityLogsWithResponseFunc func(context.Context, *govpsie.ListOptions) ([]govpsie.ActivityLog, *govpsie.Response, error)
+	ListBillingLogsFunc              func(context.Context, *govpsie.ListOptions) ([]govpsie.BillingLog, error)
+	ListAuditLogsFunc                func(context.Context, *govpsie.ListOptions) ([]govpsie.AuditLog, error)
+	ListAuditLogsWithResponseFunc    func(context.Context, *govpsie.ListOptions) ([]govpsie.AuditLog, *govpsie.Response, error)
+	ListVPSieLogsFunc                func(context.Context, *govpsie.ListOptions) ([]govpsie.VmLog, error)
+}
+
+var _ govpsie.LogsService = (*LogsService)(nil)
+
+func (m *LogsService) ListActivityLogs(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.ActivityLog, r1 error) {
+	m.record("ListActivityLogs", []interface{}{ctx, options})
+	if m.ListActivityLogsFunc == nil {
+		r1 = notStubbed("LogsService.ListActivityLogs")
+		return
+	}
+	return m.ListActivityLogsFunc(ctx, options)
+}
+
+func (m *LogsService) ListActivityLogsWithResponse(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.ActivityLog, r1 *govpsie.Response, r2 error) {
+	m.record("ListActivityLogsWithResponse", []interface{}{ctx, options})
+	if m.ListActivityLogsWithResponseFunc == nil {
+		r2 = notStubbed("LogsService.ListActivityLogsWithResponse")
+		return
+	}
+	return m.ListActivityLogsWithResponseFunc(ctx, options)
+}
+
+func (m *LogsService) ListBillingLogs(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.BillingLog, r1 error) {
+	m.record("ListBillingLogs", []interface{}{ctx, options})
+	if m.ListBillingLogsFunc == nil {
+		r1 = notStubbed("LogsService.ListBillingLogs")
+		return
+	}
+	return m.ListBillingLogsFunc(ctx, options)
+}
+
+func (m *LogsService) ListAuditLogs(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.AuditLog, r1 error) {
+	m.record("ListAuditLogs", []interface{}{ctx, options})
+	if m.ListAuditLogsFunc == nil {
+		r1 = notStubbed("LogsService.ListAuditLogs")
+		return
+	}
+	return m.ListAuditLogsFunc(ctx, options)
+}
+
+func (m *LogsService) ListAuditLogsWithResponse(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.AuditLog, r1 *govpsie.Response, r2 error) {
+	m.record("ListAuditLogsWithResponse", []interface{}{ctx, options})
+	if m.ListAuditLogsWithResponseFunc == nil {
+		r2 = notStubbed("LogsService.ListAuditLogsWithResponse")
+		return
+	}
+	return m.ListAuditLogsWithResponseFunc(ctx, options)
+}
+
+func (m *LogsService) ListVPSieLogs(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.VmLog, r1 error) {
+	m.record("ListVPSieLogs", []interface{}{ctx, options})
+	if m.ListVPSieLogsFunc == nil {
+		r1 = notStubbed("LogsService.ListVPSieLogs")
+		return
+	}
+	return m.ListVPSieLogsFunc(ctx, options)
+}
+
+// MonitoringService is a configurable fake of govpsie.MonitoringService.
+type MonitoringService struct {
+	recorder
+
+	ListMonitoringRuleFunc         func(context.Context, *govpsie.ListOptions) ([]govpsie.MonitoringRule, error)
+	CreateRuleFunc                 func(context.Context, *govpsie.CreateMonitoringRuleReq) error
+	ToggleMonitoringRuleStatusFunc func(context.Context, string, string) error
+	DeleteMonitoringRuleFunc       func(context.Context, string) error
+}
+
+var _ govpsie.MonitoringService = (*MonitoringService)(nil)
+
+func (m *MonitoringService) ListMonitoringRule(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.MonitoringRule, r1 error) {
+	m.record("ListMonitoringRule", []interface{}{ctx, options})
+	if m.ListMonitoringRuleFunc == nil {
+		r1 = notStubbed("MonitoringService.ListMonitoringRule")
+		return
+	}
+	return m.ListMonitoringRuleFunc(ctx, options)
+}
+
+func (m *MonitoringService) CreateRule(ctx context.Context, createReq *govpsie.CreateMonitoringRuleReq) (r0 error) {
+	m.record("CreateRule", []interface{}{ctx, createReq})
+	if m.CreateRuleFunc == nil {
+		r0 = notStubbed("MonitoringService.CreateRule")
+		return
+	}
+	return m.CreateRuleFunc(ctx, createReq)
+}
+
+func (m *MonitoringService) ToggleMonitoringRuleStatus(ctx context.Context, status string, ruleIdentifier string) (r0 error) {
+	m.record("ToggleMonitoringRuleStatus", []interface{}{ctx, status, ruleIdentifier})
+	if m.ToggleMonitoringRuleStatusFunc == nil {
+		r0 = notStubbed("MonitoringService.ToggleMonitoringRuleStatus")
+		return
+	}
+	return m.ToggleMonitoringRuleStatusFunc(ctx, status, ruleIdentifier)
+}
+
+func (m *MonitoringService) DeleteMonitoringRule(ctx context.Context, ruleIdentifier string) (r0 error) {
+	m.record("DeleteMonitoringRule", []interface{}{ctx, ruleIdentifier})
+	if m.DeleteMonitoringRuleFunc == nil {
+		r0 = notStubbed("MonitoringService.DeleteMonitoringRule")
+		return
+	}
+	return m.DeleteMonitoringRuleFunc(ctx, ruleIdentifier)
+}
+
+// OSService is a configurable fake of govpsie.OSService.
+type OSService struct {
+	recorder
+
+	ListFunc   func(context.Context, string) ([]govpsie.OSImage, error)
+	FindOSFunc func(context.Context, string, string, string) (*govpsie.OSImage, error)
+}
+
+var _ govpsie.OSService = (*OSService)(nil)
+
+func (m *OSService) List(ctx context.Context, dcIdentifier string) (r0 []govpsie.OSImage, r1 error) {
+	m.record("List", []interface{}{ctx, dcIdentifier})
+	if m.ListFunc == nil {
+		r1 = notStubbed("OSService.List")
+		return
+	}
+	return m.ListFunc(ctx, dcIdentifier)
+}
+
+func (m *OSService) FindOS(ctx context.Context, family string, version string, dcIdentifier string) (r0 *govpsie.OSImage, r1 error) {
+	m.record("FindOS", []interface{}{ctx, family, version, dcIdentifier})
+	if m.FindOSFunc == nil {
+		r1 = notStubbed("OSService.FindOS")
+		return
+	}
+	return m.FindOSFunc(ctx, family, version, dcIdentifier)
+}
+
+// PendingService is a configurable fake of govpsie.PendingService.
+type PendingService struct {
+	recorder
+
+	GetPendingVmsFunc func(context.Context) ([]govpsie.PendingVm, error)
+}
+
+var _ govpsie.PendingService = (*PendingService)(nil)
+
+func (m *PendingService) GetPendingVms(ctx context.Context) (r0 []govpsie.PendingVm, r1 error) {
+	m.record("GetPendingVms", []interface{}{ctx})
+	if m.GetPendingVmsFunc == nil {
+		r1 = notStubbed("PendingService.GetPendingVms")
+		return
+	}
+	return m.GetPendingVmsFunc(ctx)
+}
+
+// ProfilesService is a configurable fake of govpsie.ProfilesService.
+type ProfilesService struct {
+	recorder
+
+	ListQuickActionOfUserFunc    func(context.Context, *govpsie.ListOptions) ([]govpsie.QuickActions, error)
+	ListQuickActionOfAccountFunc func(context.Context, *govpsie.ListOptions) ([]govpsie.QuickActions, error)
+	SaveQuickActionsFunc         func(context.Context, []int) error
+	GetProfileFunc               func(context.Context) (*govpsie.Profile, error)
+	UpdateProfileFunc            func(context.Context, govpsie.UpdateProfileRequest) error
+	GetPermissionGroupsFunc      func(context.Context) ([]govpsie.PermissionGroup, error)
+	DeletePermissionGroupFunc    func(context.Context, string) error
+	CreatePermissionGroupFunc    func(context.Context, string) error
+	ChangePasswordFunc           func(context.Context, string, string) error
+	UpdateBillingFunc            func(context.Context, govpsie.BillingAddress) error
+	ValidatePhoneFunc            func(context.Context, string) error
+	VerifyPhoneFunc              func(context.Context, string) error
+	EnableTwofaFunc              func(context.Context) error
+	DisableTwofaFunc             func(context.Context) error
+}
+
+var _ govpsie.ProfilesService = (*ProfilesService)(nil)
+
+func (m *ProfilesService) ListQuickActionOfUser(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.QuickActions, r1 error) {
+	m.record("ListQuickActionOfUser", []interface{}{ctx, options})
+	if m.ListQuickActionOfUserFunc == nil {
+		r1 = notStubbed("ProfilesService.ListQuickActionOfUser")
+		return
+	}
+	return m.ListQuickActionOfUserFunc(ctx, options)
+}
+
+func (m *ProfilesService) ListQuickActionOfAccount(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.QuickActions, r1 error) {
+	m.record("ListQuickActionOfAccount", []interface{}{ctx, options})
+	if m.ListQuickActionOfAccountFunc == nil {
+		r1 = notStubbed("ProfilesService.ListQuickActionOfAccount")
+		return
+	}
+	return m.ListQuickActionOfAccountFunc(ctx, options)
+}
+
+func (m *ProfilesService) SaveQuickActions(ctx context.Context, actions []int) (r0 error) {
+	m.record("SaveQuickActions", []interface{}{ctx, actions})
+	if m.SaveQuickActionsFunc == nil {
+		r0 = notStubbed("ProfilesService.SaveQuickActions")
+		return
+	}
+	return m.SaveQuickActionsFunc(ctx, actions)
+}
+
+func (m *ProfilesService) GetProfile(ctx context.Context) (r0 *govpsie.Profile, r1 error) {
+	m.record("GetProfile", []interface{}{ctx})
+	if m.GetProfileFunc == nil {
+		r1 = notStubbed("ProfilesService.GetProfile")
+		return
+	}
+	return m.GetProfileFunc(ctx)
+}
+
+func (m *ProfilesService) UpdateProfile(a0 context.Context, a1 govpsie.UpdateProfileRequest) (r0 error) {
+	m.record("UpdateProfile", []interface{}{a0, a1})
+	if m.UpdateProfileFunc == nil {
+		r0 = notStubbed("ProfilesService.UpdateProfile")
+		return
+	}
+	return m.UpdateProfileFunc(a0, a1)
+}
+
+func (m *ProfilesService) GetPermissionGroups(ctx context.Context) (r0 []govpsie.PermissionGroup, r1 error) {
+	m.record("GetPermissionGroups", []interface{}{ctx})
+	if m.GetPermissionGroupsFunc == nil {
+		r1 = notStubbed("ProfilesService.GetPermissionGroups")
+		return
+	}
+	return m.GetPermissionGroupsFunc(ctx)
+}
+
+func (m *ProfilesService) DeletePermissionGroup(ctx context.Context, groupId string) (r0 error) {
+	m.record("DeletePermissionGroup", []interface{}{ctx, groupId})
+	if m.DeletePermissionGroupFunc == nil {
+		r0 = notStubbed("ProfilesService.DeletePermissionGroup")
+		return
+	}
+	return m.DeletePermissionGroupFunc(ctx, groupId)
+}
+
+func (m *ProfilesService) CreatePermissionGroup(ctx context.Context, groupName string) (r0 error) {
+	m.record("CreatePermissionGroup", []interface{}{ctx, groupName})
+	if m.CreatePermissionGroupFunc == nil {
+		r0 = notStubbed("ProfilesService.CreatePermissionGroup")
+		return
+	}
+	return m.CreatePermissionGroupFunc(ctx, groupName)
+}
+
+func (m *ProfilesService) ChangePassword(ctx context.Context, oldPassword string, newPassword string) (r0 error) {
+	m.record("ChangePassword", []interface{}{ctx, oldPassword, newPassword})
+	if m.ChangePasswordFunc == nil {
+		r0 = notStubbed("ProfilesService.ChangePassword")
+		return
+	}
+	return m.ChangePasswordFunc(ctx, oldPassword, newPassword)
+}
+
+func (m *ProfilesService) UpdateBilling(ctx context.Context, billing govpsie.BillingAddress) (r0 error) {
+	m.record("UpdateBilling", []interface{}{ctx, billing})
+	if m.UpdateBillingFunc == nil {
+		r0 = notStubbed("ProfilesService.UpdateBilling")
+		return
+	}
+	return m.UpdateBillingFunc(ctx, billing)
+}
+
+func (m *ProfilesService) ValidatePhone(ctx context.Context, phone string) (r0 error) {
+	m.record("ValidatePhone", []interface{}{ctx, phone})
+	if m.ValidatePhoneFunc == nil {
+		r0 = notStubbed("ProfilesService.ValidatePhone")
+		return
+	}
+	return m.ValidatePhoneFunc(ctx, phone)
+}
+
+func (m *ProfilesService) VerifyPhone(ctx context.Context, code string) (r0 error) {
+	m.record("VerifyPhone", []interface{}{ctx, code})
+	if m.VerifyPhoneFunc == nil {
+		r0 = notStubbed("ProfilesService.VerifyPhone")
+		return
+	}
+	return m.VerifyPhoneFunc(ctx, code)
+}
+
+func (m *ProfilesService) EnableTwofa(ctx context.Context) (r0 error) {
+	m.record("EnableTwofa", []interface{}{ctx})
+	if m.EnableTwofaFunc == nil {
+		r0 = notStubbed("ProfilesService.EnableTwofa")
+		return
+	}
+	return m.EnableTwofaFunc(ctx)
+}
+
+func (m *ProfilesService) DisableTwofa(ctx context.Context) (r0 error) {
+	m.record("DisableTwofa", []interface{}{ctx})
+	if m.DisableTwofaFunc == nil {
+		r0 = notStubbed("ProfilesService.DisableTwofa")
+		return
+	}
+	return m.DisableTwofaFunc(ctx)
+}
+
+// ProjectsService is a configurable fake of govpsie.ProjectsService.
+type ProjectsService struct {
+	recorder
+
+	ListFunc             func(context.Context, *govpsie.ListOptions) ([]govpsie.Project, error)
+	ListWithResponseFunc func(context.Context, *govpsie.ListOptions) ([]govpsie.Project, *govpsie.Response, error)
+	SetDefaultFunc       func(context.Context, string) error
+	GetFunc              func(context.Context, string) (*govpsie.Project, error)
+	CreateFunc           func(context.Context, *govpsie.CreateProjectRequest) error
+	ListAnotherVmsFunc   func(context.Context, string) ([]govpsie.VmData, error)
+	MoveVmsFunc          func(context.Context, string, string) error
+	AssignToVmsFunc      func(context.Context, string, string) error
+	ListDomainsFunc      func(context.Context, string) ([]govpsie.Domain, error)
+	DeleteFunc           func(context.Context, string) error
+	ListUserLimitsFunc   func(context.Context) (*govpsie.UserLimit, error)
+}
+
+var _ govpsie.ProjectsService = (*ProjectsService)(nil)
+
+func (m *ProjectsService) List(a0 context.Context, a1 *govpsie.ListOptions) (r0 []govpsie.Project, r1 error) {
+	m.record("List", []interface{}{a0, a1})
+	if m.ListFunc == nil {
+		r1 = notStubbed("ProjectsService.List")
+		return
+	}
+	return m.ListFunc(a0, a1)
+}
+
+func (m *ProjectsService) ListWithResponse(a0 context.Context, a1 *govpsie.ListOptions) (r0 []govpsie.Project, r1 *govpsie.Response, r2 error) {
+	m.record("ListWithResponse", []interface{}{a0, a1})
+	if m.ListWithResponseFunc == nil {
+		r2 = notStubbed("ProjectsService.ListWithResponse")
+		return
+	}
+	return m.ListWithResponseFunc(a0, a1)
+}
+
+func (m *ProjectsService) SetDefault(a0 context.Context, a1 string) (r0 error) {
+	m.record("SetDefault", []interface{}{a0, a1})
+	if m.SetDefaultFunc == nil {
+		r0 = notStubbed("ProjectsService.SetDefault")
+		return
+	}
+	return m.SetDefaultFunc(a0, a1)
+}
+
+func (m *ProjectsService) Get(ctx context.Context, identifer string) (r0 *govpsie.Project, r1 error) {
+	m.record("Get", []interface{}{ctx, identifer})
+	if m.GetFunc == nil {
+		r1 = notStubbed("ProjectsService.Get")
+		return
+	}
+	return m.GetFunc(ctx, identifer)
+}
+
+func (m *ProjectsService) Create(a0 context.Context, a1 *govpsie.CreateProjectRequest) (r0 error) {
+	m.record("Create", []interface{}{a0, a1})
+	if m.CreateFunc == nil {
+		r0 = notStubbed("ProjectsService.Create")
+		return
+	}
+	return m.CreateFunc(a0, a1)
+}
+
+func (m *ProjectsService) ListAnotherVms(a0 context.Context, a1 string) (r0 []govpsie.VmData, r1 error) {
+	m.record("ListAnotherVms", []interface{}{a0, a1})
+	if m.ListAnotherVmsFunc == nil {
+		r1 = notStubbed("ProjectsService.ListAnotherVms")
+		return
+	}
+	return m.ListAnotherVmsFunc(a0, a1)
+}
+
+func (m *ProjectsService) MoveVms(a0 context.Context, a1 string, a2 string) (r0 error) {
+	m.record("MoveVms", []interface{}{a0, a1, a2})
+	if m.MoveVmsFunc == nil {
+		r0 = notStubbed("ProjectsService.MoveVms")
+		return
+	}
+	return m.MoveVmsFunc(a0, a1, a2)
+}
+
+func (m *ProjectsService) AssignToVms(ctx context.Context, projectIdentifier string, projectId string) (r0 error) {
+	m.record("AssignToVms", []interface{}{ctx, projectIdentifier, projectId})
+	if m.AssignToVmsFunc == nil {
+		r0 = notStubbed("ProjectsService.AssignToVms")
+		return
+	}
+	return m.AssignToVmsFunc(ctx, projectIdentifier, projectId)
+}
+
+func (m *ProjectsService) ListDomains(ctx context.Context, projectIdentifier string) (r0 []govpsie.Domain, r1 error) {
+	m.record("ListDomains", []interface{}{ctx, projectIdentifier})
+	if m.ListDomainsFunc == nil {
+		r1 = notStubbed("ProjectsService.ListDomains")
+		return
+	}
+	return m.ListDomainsFunc(ctx, projectIdentifier)
+}
+
+func (m *ProjectsService) Delete(ctx context.Context, id string) (r0 error) {
+	m.record("Delete", []interface{}{ctx, id})
+	if m.DeleteFunc == nil {
+		r0 = notStubbed("ProjectsService.Delete")
+		return
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *ProjectsService) ListUserLimits(ctx context.Context) (r0 *govpsie.UserLimit, r1 error) {
+	m.record("ListUserLimits", []interface{}{ctx})
+	if m.ListUserLimitsFunc == nil {
+		r1 = notStubbed("ProjectsService.ListUserLimits")
+		return
+	}
+	return m.ListUserLimitsFunc(ctx)
+}
+
+// ScriptsService is a configurable fake of govpsie.ScriptsService.
+type ScriptsService struct {
+	recorder
+
+	GetScriptsFunc              func(context.Context) ([]govpsie.Script, error)
+	ListScriptsWithResponseFunc func(context.Context, *govpsie.ListOptions) ([]govpsie.Script, *govpsie.Response, error)
+	GetScriptFunc               func(context.Context, string) (govpsie.ScriptDetail, error)
+	CreateScriptFunc            func(context.Context, *govpsie.CreateScriptRequest) error
+	UpdateScriptFunc            func(context.Context, *govpsie.ScriptUpdateRequest) error
+	DeleteScriptFunc            func(context.Context, string) error
+}
+
+var _ govpsie.ScriptsService = (*ScriptsService)(nil)
+
+func (m *ScriptsService) GetScripts(ctx context.Context) (r0 []govpsie.Script, r1 error) {
+	m.record("GetScripts", []interface{}{ctx})
+	if m.GetScriptsFunc == nil {
+		r1 = notStubbed("ScriptsService.GetScripts")
+		return
+	}
+	return m.GetScriptsFunc(ctx)
+}
+
+func (m *ScriptsService) ListScriptsWithResponse(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.Script, r1 *govpsie.Response, r2 error) {
+	m.record("ListScriptsWithResponse", []interface{}{ctx, options})
+	if m.ListScriptsWithResponseFunc == nil {
+		r2 = notStubbed("ScriptsService.ListScriptsWithResponse")
+		return
+	}
+	return m.ListScriptsWithResponseFunc(ctx, options)
+}
+
+func (m *ScriptsService) GetScript(ctx context.Context, scriptId string) (r0 govpsie.ScriptDetail, r1 error) {
+	m.record("GetScript", []interface{}{ctx, scriptId})
+	if m.GetScriptFunc == nil {
+		r1 = notStubbed("ScriptsService.GetScript")
+		return
+	}
+	return m.GetScriptFunc(ctx, scriptId)
+}
+
+func (m *ScriptsService) CreateScript(ctx context.Context, createScriptRequest *govpsie.CreateScriptRequest) (r0 error) {
+	m.record("CreateScript", []interface{}{ctx, createScriptRequest})
+	if m.CreateScriptFunc == nil {
+		r0 = notStubbed("ScriptsService.CreateScript")
+		return
+	}
+	return m.CreateScriptFunc(ctx, createScriptRequest)
+}
+
+func (m *ScriptsService) UpdateScript(ctx context.Context, scriptUpdateRequest *govpsie.ScriptUpdateRequest) (r0 error) {
+	m.record("UpdateScript", []interface{}{ctx, scriptUpdateRequest})
+	if m.UpdateScriptFunc == nil {
+		r0 = notStubbed("ScriptsService.UpdateScript")
+		return
+	}
+	return m.UpdateScriptFunc(ctx, scriptUpdateRequest)
+}
+
+func (m *ScriptsService) DeleteScript(ctx context.Context, scriptId string) (r0 error) {
+	m.record("DeleteScript", []interface{}{ctx, scriptId})
+	if m.DeleteScriptFunc == nil {
+		r0 = notStubbed("ScriptsService.DeleteScript")
+		return
+	}
+	return m.DeleteScriptFunc(ctx, scriptId)
+}
+
+// ServerService is a configurable fake of govpsie.ServerService.
+type ServerService struct {
+	recorder
+
+	ListServerFunc                  func(context.Context, *govpsie.ListOptions, string) ([]govpsie.VmData, error)
+	ListServerWithResponseFunc      func(context.Context, *govpsie.ListOptions, string) ([]govpsie.VmData, *govpsie.Response, error)
+	ListFunc                        func(context.Context, *govpsie.ListOptions) ([]govpsie.VmData, error)
+	ListWithResponseFunc            func(context.Context, *govpsie.ListOptions) ([]govpsie.VmData, *govpsie.Response, error)
+	GetServerByIdentifierFunc       func(context.Context, string) (*govpsie.VmData, error)
+	GetServerDetailsFunc            func(context.Context, string) (*govpsie.ServerDetails, error)
+	GetServerStatusByIdentifierFunc func(context.Context, string) (*govpsie.Status, error)
+	GetServerConsoleFunc            func(context.Context, string) (*govpsie.ServerConsole, error)
+	CreateServerFunc                func(context.Context, *govpsie.CreateServerRequest) (*govpsie.ServerCreation, error)
+	DeleteServerFunc                func(context.Context, string, string, string, string) error
+	StartServerFunc                 func(context.Context, string) error
+	StopServerFunc                  func(context.Context, string) error
+	RestartServerFunc               func(context.Context, string) error
+	ChangePasswordFunc              func(context.Context, string, string) error
+	ChangeHostNameFunc              func(context.Context, string, string) error
+	AddVPCFunc                      func(context.Context, *govpsie.VpcRequest) error
+	MoveVPCFunc                     func(context.Context, *govpsie.VpcRequest) error
+	AddTagsFunc                     func(context.Context, string, []string) error
+	ResizeServerFunc                func(context.Context, string, string, string) error
+	ResizeDiskFunc                  func(context.Context, string, int) error
+	AddSshFunc                      func(context.Context, string, string) error
+	AddScriptFunc                   func(context.Context, string, string) error
+	LockFunc                        func(context.Context, string) error
+	UnLockFunc                      func(context.Context, string) error
+	DoMultiActionsFunc              func(context.Context, []string, string, string) error
+	EnableIpv6Func                  func(context.Context, string) error
+	EnableIpv4Func                  func(context.Context, string) error
+	AddFipFunc                      func(context.Context, string, string) error
+	ResumeFunc                      func(context.Context, *govpsie.ResumeReq) error
+	ResetNetworkFunc                func(context.Context, string) error
+	EditTagFunc                     func(context.Context, []string, string) error
+	ResetAllFirewallsFunc           func(context.Context) error
+	ListVirtualMachinesFunc         func(context.Context) ([]govpsie.VirtualMachine, error)
+	ListAllNodesOfUserFunc          func(context.Context) ([]govpsie.VmData, error)
+	CheckAgentStatusFunc            func(context.Context, string) (bool, error)
+	ListResourcePlansFunc           func(context.Context, string) ([]govpsie.ResourcePlan, error)
+	WaitForServerStateFunc          func(context.Context, string, govpsie.ServerState, *govpsie.WaitOptions) (*govpsie.VmData, error)
+	CreateServerAndWaitFunc         func(context.Context, *govpsie.CreateServerRequest, *govpsie.WaitOptions) (*govpsie.VmData, error)
+	StartServerAndWaitFunc          func(context.Context, string, *govpsie.WaitOptions) (*govpsie.VmData, error)
+	StopServerAndWaitFunc           func(context.Context, string, *govpsie.WaitOptions) (*govpsie.VmData, error)
+	RestartServerAndWaitFunc        func(context.Context, string, *govpsie.WaitOptions) (*govpsie.VmData, error)
+	ResizeServerAndWaitFunc         func(context.Context, string, string, string, *govpsie.WaitOptions) (*govpsie.VmData, error)
+	ResumeAndWaitFunc               func(context.Context, *govpsie.ResumeReq, *govpsie.WaitOptions) (*govpsie.VmData, error)
+}
+
+var _ govpsie.ServerService = (*ServerService)(nil)
+
+func (m *ServerService) ListServer(a0 context.Context, a1 *govpsie.ListOptions, a2 string) (r0 []govpsie.VmData, r1 error) {
+	m.record("ListServer", []interface{}{a0, a1, a2})
+	if m.ListServerFunc == nil {
+		r1 = notStubbed("ServerService.ListServer")
+		return
+	}
+	return m.ListServerFunc(a0, a1, a2)
+}
+
+func (m *ServerService) ListServerWithResponse(a0 context.Context, a1 *govpsie.ListOptions, a2 string) (r0 []govpsie.VmData, r1 *govpsie.Response, r2 error) {
+	m.record("ListServerWithResponse", []interface{}{a0, a1, a2})
+	if m.ListServerWithResponseFunc == nil {
+		r2 = notStubbed("ServerService.ListServerWithResponse")
+		return
+	}
+	return m.ListServerWithResponseFunc(a0, a1, a2)
+}
+
+func (m *ServerService) List(a0 context.Context, a1 *govpsie.ListOptions) (r0 []govpsie.VmData, r1 error) {
+	m.record("List", []interface{}{a0, a1})
+	if m.ListFunc == nil {
+		r1 = notStubbed("ServerService.List")
+		return
+	}
+	return m.ListFunc(a0, a1)
+}
+
+func (m *ServerService) ListWithResponse(a0 context.Context, a1 *govpsie.ListOptions) (r0 []govpsie.VmData, r1 *govpsie.Response, r2 error) {
+	m.record("ListWithResponse", []interface{}{a0, a1})
+	if m.ListWithResponseFunc == nil {
+		r2 = notStubbed("ServerService.ListWithResponse")
+		return
+	}
+	return m.ListWithResponseFunc(a0, a1)
+}
+
+func (m *ServerService) GetServerByIdentifier(a0 context.Context, a1 string) (r0 *govpsie.VmData, r1 error) {
+	m.record("GetServerByIdentifier", []interface{}{a0, a1})
+	if m.GetServerByIdentifierFunc == nil {
+		r1 = notStubbed("ServerService.GetServerByIdentifier")
+		return
+	}
+	return m.GetServerByIdentifierFunc(a0, a1)
+}
+
+func (m *ServerService) GetServerDetails(ctx context.Context, identifierId string) (r0 *govpsie.ServerDetails, r1 error) {
+	m.record("GetServerDetails", []interface{}{ctx, identifierId})
+	if m.GetServerDetailsFunc == nil {
+		r1 = notStubbed("ServerService.GetServerDetails")
+		return
+	}
+	return m.GetServerDetailsFunc(ctx, identifierId)
+}
+
+func (m *ServerService) GetServerStatusByIdentifier(a0 context.Context, a1 string) (r0 *govpsie.Status, r1 error) {
+	m.record("GetServerStatusByIdentifier", []interface{}{a0, a1})
+	if m.GetServerStatusByIdentifierFunc == nil {
+		r1 = notStubbed("ServerService.GetServerStatusByIdentifier")
+		return
+	}
+	return m.GetServerStatusByIdentifierFunc(a0, a1)
+}
+
+func (m *ServerService) GetServerConsole(ctx context.Context, identifierId string) (r0 *govpsie.ServerConsole, r1 error) {
+	m.record("GetServerConsole", []interface{}{ctx, identifierId})
+	if m.GetServerConsoleFunc == nil {
+		r1 = notStubbed("ServerService.GetServerConsole")
+		return
+	}
+	return m.GetServerConsoleFunc(ctx, identifierId)
+}
+
+func (m *ServerService) CreateServer(a0 context.Context, a1 *govpsie.CreateServerRequest) (r0 *govpsie.ServerCreation, r1 error) {
+	m.record("CreateServer", []interface{}{a0, a1})
+	if m.CreateServerFunc == nil {
+		r1 = notStubbed("ServerService.CreateServer")
+		return
+	}
+	return m.CreateServerFunc(a0, a1)
+}
+
+func (m *ServerService) DeleteServer(ctx context.Context, identifierId string, password string, reason string, note string) (r0 error) {
+	m.record("DeleteServer", []interface{}{ctx, identifierId, password, reason, note})
+	if m.DeleteServerFunc == nil {
+		r0 = notStubbed("ServerService.DeleteServer")
+		return
+	}
+	return m.DeleteServerFunc(ctx, identifierId, password, reason, note)
+}
+
+func (m *ServerService) StartServer(ctx context.Context, identifierId string) (r0 error) {
+	m.record("StartServer", []interface{}{ctx, identifierId})
+	if m.StartServerFunc == nil {
+		r0 = notStubbed("ServerService.StartServer")
+		return
+	}
+	return m.StartServerFunc(ctx, identifierId)
+}
+
+func (m *ServerService) StopServer(ctx context.Context, identifierId string) (r0 error) {
+	m.record("StopServer", []interface{}{ctx, identifierId})
+	if m.StopServerFunc == nil {
+		r0 = notStubbed("ServerService.StopServer")
+		return
+	}
+	return m.StopServerFunc(ctx, identifierId)
+}
+
+func (m *ServerService) RestartServer(ctx context.Context, identifierId string) (r0 error) {
+	m.record("RestartServer", []interface{}{ctx, identifierId})
+	if m.RestartServerFunc == nil {
+		r0 = notStubbed("ServerService.RestartServer")
+		return
+	}
+	return m.RestartServerFunc(ctx, identifierId)
+}
+
+func (m *ServerService) ChangePassword(ctx context.Context, identifierId string, newPassword string) (r0 error) {
+	m.record("ChangePassword", []interface{}{ctx, identifierId, newPassword})
+	if m.ChangePasswordFunc == nil {
+		r0 = notStubbed("ServerService.ChangePassword")
+		return
+	}
+	return m.ChangePasswordFunc(ctx, identifierId, newPassword)
+}
+
+func (m *ServerService) ChangeHostName(ctx context.Context, identifierId string, newHostname string) (r0 error) {
+	m.record("ChangeHostName", []interface{}{ctx, identifierId, newHostname})
+	if m.ChangeHostNameFunc == nil {
+		r0 = notStubbed("ServerService.ChangeHostName")
+		return
+	}
+	return m.ChangeHostNameFunc(ctx, identifierId, newHostname)
+}
+
+func (m *ServerService) AddVPC(ctx context.Context, request *govpsie.VpcRequest) (r0 error) {
+	m.record("AddVPC", []interface{}{ctx, request})
+	if m.AddVPCFunc == nil {
+		r0 = notStubbed("ServerService.AddVPC")
+		return
+	}
+	return m.AddVPCFunc(ctx, request)
+}
+
+func (m *ServerService) MoveVPC(ctx context.Context, request *govpsie.VpcRequest) (r0 error) {
+	m.record("MoveVPC", []interface{}{ctx, request})
+	if m.MoveVPCFunc == nil {
+		r0 = notStubbed("ServerService.MoveVPC")
+		return
+	}
+	return m.MoveVPCFunc(ctx, request)
+}
+
+func (m *ServerService) AddTags(ctx context.Context, identifierId string, tags []string) (r0 error) {
+	m.record("AddTags", []interface{}{ctx, identifierId, tags})
+	if m.AddTagsFunc == nil {
+		r0 = notStubbed("ServerService.AddTags")
+		return
+	}
+	return m.AddTagsFunc(ctx, identifierId, tags)
+}
+
+func (m *ServerService) ResizeServer(ctx context.Context, identifierId string, cpu string, ram string) (r0 error) {
+	m.record("ResizeServer", []interface{}{ctx, identifierId, cpu, ram})
+	if m.ResizeServerFunc == nil {
+		r0 = notStubbed("ServerService.ResizeServer")
+		return
+	}
+	return m.ResizeServerFunc(ctx, identifierId, cpu, ram)
+}
+
+func (m *ServerService) ResizeDisk(ctx context.Context, identifierId string, ssd int) (r0 error) {
+	m.record("ResizeDisk", []interface{}{ctx, identifierId, ssd})
+	if m.ResizeDiskFunc == nil {
+		r0 = notStubbed("ServerService.ResizeDisk")
+		return
+	}
+	return m.ResizeDiskFunc(ctx, identifierId, ssd)
+}
+
+func (m *ServerService) AddSsh(ctx context.Context, identifierId string, sshKeyIdentifier string) (r0 error) {
+	m.record("AddSsh", []interface{}{ctx, identifierId, sshKeyIdentifier})
+	if m.AddSshFunc == nil {
+		r0 = notStubbed("ServerService.AddSsh")
+		return
+	}
+	return m.AddSshFunc(ctx, identifierId, sshKeyIdentifier)
+}
+
+func (m *ServerService) AddScript(ctx context.Context, identifierId string, scriptIdentifier string) (r0 error) {
+	m.record("AddScript", []interface{}{ctx, identifierId, scriptIdentifier})
+	if m.AddScriptFunc == nil {
+		r0 = notStubbed("ServerService.AddScript")
+		return
+	}
+	return m.AddScriptFunc(ctx, identifierId, scriptIdentifier)
+}
+
+func (m *ServerService) Lock(ctx context.Context, identifierId string) (r0 error) {
+	m.record("Lock", []interface{}{ctx, identifierId})
+	if m.LockFunc == nil {
+		r0 = notStubbed("ServerService.Lock")
+		return
+	}
+	return m.LockFunc(ctx, identifierId)
+}
+
+func (m *ServerService) UnLock(ctx context.Context, identifierId string) (r0 error) {
+	m.record("UnLock", []interface{}{ctx, identifierId})
+	if m.UnLockFunc == nil {
+		r0 = notStubbed("ServerService.UnLock")
+		return
+	}
+	return m.UnLockFunc(ctx, identifierId)
+}
+
+func (m *ServerService) DoMultiActions(ctx context.Context, vmsIdentifiers []string, actionType string, sshKeyIdentifier string) (r0 error) {
+	m.record("DoMultiActions", []interface{}{ctx, vmsIdentifiers, actionType, sshKeyIdentifier})
+	if m.DoMultiActionsFunc == nil {
+		r0 = notStubbed("ServerService.DoMultiActions")
+		return
+	}
+	return m.DoMultiActionsFunc(ctx, vmsIdentifiers, actionType, sshKeyIdentifier)
+}
+
+func (m *ServerService) EnableIpv6(ctx context.Context, identifierId string) (r0 error) {
+	m.record("EnableIpv6", []interface{}{ctx, identifierId})
+	if m.EnableIpv6Func == nil {
+		r0 = notStubbed("ServerService.EnableIpv6")
+		return
+	}
+	return m.EnableIpv6Func(ctx, identifierId)
+}
+
+func (m *ServerService) EnableIpv4(ctx context.Context, identifierId string) (r0 error) {
+	m.record("EnableIpv4", []interface{}{ctx, identifierId})
+	if m.EnableIpv4Func == nil {
+		r0 = notStubbed("ServerService.EnableIpv4")
+		return
+	}
+	return m.EnableIpv4Func(ctx, identifierId)
+}
+
+func (m *ServerService) AddFip(ctx context.Context, identifierId string, dcIdentifier string) (r0 error) {
+	m.record("AddFip", []interface{}{ctx, identifierId, dcIdentifier})
+	if m.AddFipFunc == nil {
+		r0 = notStubbed("ServerService.AddFip")
+		return
+	}
+	return m.AddFipFunc(ctx, identifierId, dcIdentifier)
+}
+
+func (m *ServerService) Resume(ctx context.Context, resumeReq *govpsie.ResumeReq) (r0 error) {
+	m.record("Resume", []interface{}{ctx, resumeReq})
+	if m.ResumeFunc == nil {
+		r0 = notStubbed("ServerService.Resume")
+		return
+	}
+	return m.ResumeFunc(ctx, resumeReq)
+}
+
+func (m *ServerService) ResetNetwork(ctx context.Context, vmIdentifier string) (r0 error) {
+	m.record("ResetNetwork", []interface{}{ctx, vmIdentifier})
+	if m.ResetNetworkFunc == nil {
+		r0 = notStubbed("ServerService.ResetNetwork")
+		return
+	}
+	return m.ResetNetworkFunc(ctx, vmIdentifier)
+}
+
+func (m *ServerService) EditTag(ctx context.Context, tags []string, vmIdentifer string) (r0 error) {
+	m.record("EditTag", []interface{}{ctx, tags, vmIdentifer})
+	if m.EditTagFunc == nil {
+		r0 = notStubbed("ServerService.EditTag")
+		return
+	}
+	return m.EditTagFunc(ctx, tags, vmIdentifer)
+}
+
+func (m *ServerService) ResetAllFirewalls(ctx context.Context) (r0 error) {
+	m.record("ResetAllFirewalls", []interface{}{ctx})
+	if m.ResetAllFirewallsFunc == nil {
+		r0 = notStubbed("ServerService.ResetAllFirewalls")
+		return
+	}
+	return m.ResetAllFirewallsFunc(ctx)
+}
+
+func (m *ServerService) ListVirtualMachines(ctx context.Context) (r0 []govpsie.VirtualMachine, r1 error) {
+	m.record("ListVirtualMachines", []interface{}{ctx})
+	if m.ListVirtualMachinesFunc == nil {
+		r1 = notStubbed("ServerService.ListVirtualMachines")
+		return
+	}
+	return m.ListVirtualMachinesFunc(ctx)
+}
+
+func (m *ServerService) ListAllNodesOfUser(ctx context.Context) (r0 []govpsie.VmData, r1 error) {
+	m.record("ListAllNodesOfUser", []interface{}{ctx})
+	if m.ListAllNodesOfUserFunc == nil {
+		r1 = notStubbed("ServerService.ListAllNodesOfUser")
+		return
+	}
+	return m.ListAllNodesOfUserFunc(ctx)
+}
+
+func (m *ServerService) CheckAgentStatus(ctx context.Context, vmIdentifier string) (r0 bool, r1 error) {
+	m.record("CheckAgentStatus", []interface{}{ctx, vmIdentifier})
+	if m.CheckAgentStatusFunc == nil {
+		r1 = notStubbed("ServerService.CheckAgentStatus")
+		return
+	}
+	return m.CheckAgentStatusFunc(ctx, vmIdentifier)
+}
+
+func (m *ServerService) ListResourcePlans(ctx context.Context, dcIdentifier string) (r0 []govpsie.ResourcePlan, r1 error) {
+	m.record("ListResourcePlans", []interface{}{ctx, dcIdentifier})
+	if m.ListResourcePlansFunc == nil {
+		r1 = notStubbed("ServerService.ListResourcePlans")
+		return
+	}
+	return m.ListResourcePlansFunc(ctx, dcIdentifier)
+}
+
+func (m *ServerService) WaitForServerState(ctx context.Context, identifierId string, state govpsie.ServerState, opts *govpsie.WaitOptions) (r0 *govpsie.VmData, r1 error) {
+	m.record("WaitForServerState", []interface{}{ctx, identifierId, state, opts})
+	if m.WaitForServerStateFunc == nil {
+		r1 = notStubbed("ServerService.WaitForServerState")
+		return
+	}
+	return m.WaitForServerStateFunc(ctx, identifierId, state, opts)
+}
+
+func (m *ServerService) CreateServerAndWait(ctx context.Context, server *govpsie.CreateServerRequest, opts *govpsie.WaitOptions) (r0 *govpsie.VmData, r1 error) {
+	m.record("CreateServerAndWait", []interface{}{ctx, server, opts})
+	if m.CreateServerAndWaitFunc == nil {
+		r1 = notStubbed("ServerService.CreateServerAndWait")
+		return
+	}
+	return m.CreateServerAndWaitFunc(ctx, server, opts)
+}
+
+func (m *ServerService) StartServerAndWait(ctx context.Context, identifierId string, opts *govpsie.WaitOptions) (r0 *govpsie.VmData, r1 error) {
+	m.record("StartServerAndWait", []interface{}{ctx, identifierId, opts})
+	if m.StartServerAndWaitFunc == nil {
+		r1 = notStubbed("ServerService.StartServerAndWait")
+		return
+	}
+	return m.StartServerAndWaitFunc(ctx, identifierId, opts)
+}
+
+func (m *ServerService) StopServerAndWait(ctx context.Context, identifierId string, opts *govpsie.WaitOptions) (r0 *govpsie.VmData, r1 error) {
+	m.record("StopServerAndWait", []interface{}{ctx, identifierId, opts})
+	if m.StopServerAndWaitFunc == nil {
+		r1 = notStubbed("ServerService.StopServerAndWait")
+		return
+	}
+	return m.StopServerAndWaitFunc(ctx, identifierId, opts)
+}
+
+func (m *ServerService) RestartServerAndWait(ctx context.Context, identifierId string, opts *govpsie.WaitOptions) (r0 *govpsie.VmData, r1 error) {
+	m.record("RestartServerAndWait", []interface{}{ctx, identifierId, opts})
+	if m.RestartServerAndWaitFunc == nil {
+		r1 = notStubbed("ServerService.RestartServerAndWait")
+		return
+	}
+	return m.RestartServerAndWaitFunc(ctx, identifierId, opts)
+}
+
+func (m *ServerService) ResizeServerAndWait(ctx context.Context, identifierId string, cpu string, ram string, opts *govpsie.WaitOptions) (r0 *govpsie.VmData, r1 error) {
+	m.record("ResizeServerAndWait", []interface{}{ctx, identifierId, cpu, ram, opts})
+	if m.ResizeServerAndWaitFunc == nil {
+		r1 = notStubbed("ServerService.ResizeServerAndWait")
+		return
+	}
+	return m.ResizeServerAndWaitFunc(ctx, identifierId, cpu, ram, opts)
+}
+
+func (m *ServerService) ResumeAndWait(ctx context.Context, resumeReq *govpsie.ResumeReq, opts *govpsie.WaitOptions) (r0 *govpsie.VmData, r1 error) {
+	m.record("ResumeAndWait", []interface{}{ctx, resumeReq, opts})
+	if m.ResumeAndWaitFunc == nil {
+		r1 = notStubbed("ServerService.ResumeAndWait")
+		return
+	}
+	return m.ResumeAndWaitFunc(ctx, resumeReq, opts)
+}
+
+// SnapshotService is a configurable fake of govpsie.SnapshotService.
+type SnapshotService struct {
+	recorder
+
+	ListFunc                       func(context.Context, *govpsie.ListOptions) ([]govpsie.Snapshot, error)
+	ListWithResponseFunc           func(context.Context, *govpsie.ListOptions) ([]govpsie.Snapshot, *govpsie.Response, error)
+	CreateFunc                     func(context.Context, string, string, string) error
+	ListByVmFunc                   func(context.Context, *govpsie.ListOptions, string) ([]govpsie.Snapshot, error)
+	RollbackFunc                   func(context.Context, string) error
+	EnableAutoFunc                 func(context.Context, *govpsie.EnableAutoSnapshotReq) error
+	DeleteFunc                     func(context.Context, string, string, string) error
+	UpdateFunc                     func(context.Context, string, string) error
+	GetFunc                        func(context.Context, string) (*govpsie.Snapshot, error)
+	GetSnapShotPolicyFunc          func(context.Context, string) (*govpsie.SnapShotPolicy, error)
+	CreateSnapShotPolicyFunc       func(context.Context, *govpsie.CreateSnapShotPolicyReq) error
+	DeleteSnapShotPolicyFunc       func(context.Context, string, string) error
+	ManageRetainSnapShotPolicyFunc func(context.Context, string, int64) error
+	AttachSnapShotPolicyFunc       func(context.Context, string, []string) error
+	DetachSnapShotPolicyFunc       func(context.Context, string, []string) error
+	ListSnapShotPoliciesFunc       func(context.Context, *govpsie.ListOptions) ([]govpsie.SnapShotPolicyListDetail, error)
+}
+
+var _ govpsie.SnapshotService = (*SnapshotService)(nil)
+
+func (m *SnapshotService) List(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.Snapshot, r1 error) {
+	m.record("List", []interface{}{ctx, options})
+	if m.ListFunc == nil {
+		r1 = notStubbed("SnapshotService.List")
+		return
+	}
+	return m.ListFunc(ctx, options)
+}
+
+func (m *SnapshotService) ListWithResponse(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.Snapshot, r1 *govpsie.Response, r2 error) {
+	m.record("ListWithResponse", []interface{}{ctx, options})
+	if m.ListWithResponseFunc == nil {
+		r2 = notStubbed("SnapshotService.ListWithResponse")
+		return
+	}
+	return m.ListWithResponseFunc(ctx, options)
+}
+
+func (m *SnapshotService) Create(ctx context.Context, name string, vmIdentifier string, note string) (r0 error) {
+	m.record("Create", []interface{}{ctx, name, vmIdentifier, note})
+	if m.CreateFunc == nil {
+		r0 = notStubbed("SnapshotService.Create")
+		return
+	}
+	return m.CreateFunc(ctx, name, vmIdentifier, note)
+}
+
+func (m *SnapshotService) ListByVm(ctx context.Context, options *govpsie.ListOptions, vmIdentifier string) (r0 []govpsie.Snapshot, r1 error) {
+	m.record("ListByVm", []interface{}{ctx, options, vmIdentifier})
+	if m.ListByVmFunc == nil {
+		r1 = notStubbed("SnapshotService.ListByVm")
+		return
+	}
+	return m.ListByVmFunc(ctx, options, vmIdentifier)
+}
+
+func (m *SnapshotService) Rollback(ctx context.Context, snapshotIdentifier string) (r0 error) {
+	m.record("Rollback", []interface{}{ctx, snapshotIdentifier})
+	if m.RollbackFunc == nil {
+		r0 = notStubbed("SnapshotService.Rollback")
+		return
+	}
+	return m.RollbackFunc(ctx, snapshotIdentifier)
+}
+
+func (m *SnapshotService) EnableAuto(ctx context.Context, enableReq *govpsie.EnableAutoSnapshotReq) (r0 error) {
+	m.record("EnableAuto", []interface{}{ctx, enableReq})
+	if m.EnableAutoFunc == nil {
+		r0 = notStubbed("SnapshotService.EnableAuto")
+		return
+	}
+	return m.EnableAutoFunc(ctx, enableReq)
+}
+
+func (m *SnapshotService) Delete(ctx context.Context, snapshotIdentifier string, reason string, note string) (r0 error) {
+	m.record("Delete", []interface{}{ctx, snapshotIdentifier, reason, note})
+	if m.DeleteFunc == nil {
+		r0 = notStubbed("SnapshotService.Delete")
+		return
+	}
+	return m.DeleteFunc(ctx, snapshotIdentifier, reason, note)
+}
+
+func (m *SnapshotService) Update(ctx context.Context, snapshotIdentifier string, newNote string) (r0 error) {
+	m.record("Update", []interface{}{ctx, snapshotIdentifier, newNote})
+	if m.UpdateFunc == nil {
+		r0 = notStubbed("SnapshotService.Update")
+		return
+	}
+	return m.UpdateFunc(ctx, snapshotIdentifier, newNote)
+}
+
+func (m *SnapshotService) Get(ctx context.Context, buckupIdentifier string) (r0 *govpsie.Snapshot, r1 error) {
+	m.record("Get", []interface{}{ctx, buckupIdentifier})
+	if m.GetFunc == nil {
+		r1 = notStubbed("SnapshotService.Get")
+		return
+	}
+	return m.GetFunc(ctx, buckupIdentifier)
+}
+
+func (m *SnapshotService) GetSnapShotPolicy(ctx context.Context, identifier string) (r0 *govpsie.SnapShotPolicy, r1 error) {
+	m.record("GetSnapShotPolicy", []interface{}{ctx, identifier})
+	if m.GetSnapShotPolicyFunc == nil {
+		r1 = notStubbed("SnapshotService.GetSnapShotPolicy")
+		return
+	}
+	return m.GetSnapShotPolicyFunc(ctx, identifier)
+}
+
+func (m *SnapshotService) CreateSnapShotPolicy(ctx context.Context, createReq *govpsie.CreateSnapShotPolicyReq) (r0 error) {
+	m.record("CreateSnapShotPolicy", []interface{}{ctx, createReq})
+	if m.CreateSnapShotPolicyFunc == nil {
+		r0 = notStubbed("SnapshotService.CreateSnapShotPolicy")
+		return
+	}
+	return m.CreateSnapShotPolicyFunc(ctx, createReq)
+}
+
+func (m *SnapshotService) DeleteSnapShotPolicy(ctx context.Context, policyId string, identifier string) (r0 error) {
+	m.record("DeleteSnapShotPolicy", []interface{}{ctx, policyId, identifier})
+	if m.DeleteSnapShotPolicyFunc == nil {
+		r0 = notStubbed("SnapshotService.DeleteSnapShotPolicy")
+		return
+	}
+	return m.DeleteSnapShotPolicyFunc(ctx, policyId, identifier)
+}
+
+func (m *SnapshotService) ManageRetainSnapShotPolicy(ctx context.Context, policyId string, keep int64) (r0 error) {
+	m.record("ManageRetainSnapShotPolicy", []interface{}{ctx, policyId, keep})
+	if m.ManageRetainSnapShotPolicyFunc == nil {
+		r0 = notStubbed("SnapshotService.ManageRetainSnapShotPolicy")
+		return
+	}
+	return m.ManageRetainSnapShotPolicyFunc(ctx, policyId, keep)
+}
+
+func (m *SnapshotService) AttachSnapShotPolicy(ctx context.Context, policyId string, vms []string) (r0 error) {
+	m.record("AttachSnapShotPolicy", []interface{}{ctx, policyId, vms})
+	if m.AttachSnapShotPolicyFunc == nil {
+		r0 = notStubbed("SnapshotService.AttachSnapShotPolicy")
+		return
+	}
+	return m.AttachSnapShotPolicyFunc(ctx, policyId, vms)
+}
+
+func (m *SnapshotService) DetachSnapShotPolicy(ctx context.Context, policyId string, vms []string) (r0 error) {
+	m.record("DetachSnapShotPolicy", []interface{}{ctx, policyId, vms})
+	if m.DetachSnapShotPolicyFunc == nil {
+		r0 = notStubbed("SnapshotService.DetachSnapShotPolicy")
+		return
+	}
+	return m.DetachSnapShotPolicyFunc(ctx, policyId, vms)
+}
+
+func (m *SnapshotService) ListSnapShotPolicies(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.SnapShotPolicyListDetail, r1 error) {
+	m.record("ListSnapShotPolicies", []interface{}{ctx, options})
+	if m.ListSnapShotPoliciesFunc == nil {
+		r1 = notStubbed("SnapshotService.ListSnapShotPolicies")
+		return
+	}
+	return m.ListSnapShotPoliciesFunc(ctx, options)
+}
+
+// SshkeysService is a configurable fake of govpsie.SshkeysService.
+type SshkeysService struct {
+	recorder
+
+	ListFunc   func(context.Context) ([]govpsie.SShKey, error)
+	DeleteFunc func(context.Context, string) error
+	GetFunc    func(context.Context, string) (*govpsie.SShKey, error)
+	CreateFunc func(context.Context, string, string) error
+}
+
+var _ govpsie.SshkeysService = (*SshkeysService)(nil)
+
+func (m *SshkeysService) List(a0 context.Context) (r0 []govpsie.SShKey, r1 error) {
+	m.record("List", []interface{}{a0})
+	if m.ListFunc == nil {
+		r1 = notStubbed("SshkeysService.List")
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+func (m *SshkeysService) Delete(a0 context.Context, a1 string) (r0 error) {
+	m.record("Delete", []interface{}{a0, a1})
+	if m.DeleteFunc == nil {
+		r0 = notStubbed("SshkeysService.Delete")
+		return
+	}
+	return m.DeleteFunc(a0, a1)
+}
+
+func (m *SshkeysService) Get(a0 context.Context, a1 string) (r0 *govpsie.SShKey, r1 error) {
+	m.record("Get", []interface{}{a0, a1})
+	if m.GetFunc == nil {
+		r1 = notStubbed("SshkeysService.Get")
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+func (m *SshkeysService) Create(a0 context.Context, a1 string, a2 string) (r0 error) {
+	m.record("Create", []interface{}{a0, a1, a2})
+	if m.CreateFunc == nil {
+		r0 = notStubbed("SshkeysService.Create")
+		return
+	}
+	return m.CreateFunc(a0, a1, a2)
+}
+
+// StatisticsService is a configurable fake of govpsie.StatisticsService.
+type StatisticsService struct {
+	recorder
+
+	GetServerStatisticsFunc func(context.Context, string, *govpsie.StatisticsRequest) (*govpsie.ServerStatistics, error)
+	GetTrafficUsageFunc     func(context.Context, string, time.Time, time.Time) (*govpsie.TrafficUsage, error)
+}
+
+var _ govpsie.StatisticsService = (*StatisticsService)(nil)
+
+func (m *StatisticsService) GetServerStatistics(ctx context.Context, vmIdentifier string, req *govpsie.StatisticsRequest) (r0 *govpsie.ServerStatistics, r1 error) {
+	m.record("GetServerStatistics", []interface{}{ctx, vmIdentifier, req})
+	if m.GetServerStatisticsFunc == nil {
+		r1 = notStubbed("StatisticsService.GetServerStatistics")
+		return
+	}
+	return m.GetServerStatisticsFunc(ctx, vmIdentifier, req)
+}
+
+func (m *StatisticsService) GetTrafficUsage(ctx context.Context, vmIdentifier string, from time.Time, to time.Time) (r0 *govpsie.TrafficUsage, r1 error) {
+	m.record("GetTrafficUsage", []interface{}{ctx, vmIdentifier, from, to})
+	if m.GetTrafficUsageFunc == nil {
+		r1 = notStubbed("StatisticsService.GetTrafficUsage")
+		return
+	}
+	return m.GetTrafficUsageFunc(ctx, vmIdentifier, from, to)
+}
+
+// StorageService is a configurable fake of govpsie.StorageService.
+type StorageService struct {
+	recorder
+
+	ListFunc                      func(context.Context, *govpsie.ListOptions) ([]govpsie.Storage, error)
+	ListWithResponseFunc          func(context.Context, *govpsie.ListOptions) ([]govpsie.Storage, *govpsie.Response, error)
+	DeleteFunc                    func(context.Context, string) error
+	AttachToServerFunc            func(context.Context, string, string, string) error
+	DetachToServerFunc            func(context.Context, string, string, string) error
+	CreateContainerFunc           func(context.Context, string) error
+	ListAllFunc                   func(context.Context, *govpsie.ListOptions) ([]govpsie.Storage, error)
+	CreateFunc                    func(context.Context, *govpsie.StorageCreateRequest, string, string) error
+	ListVmsToAttachFunc           func(context.Context) ([]govpsie.VmToAttach, error)
+	CreateVolumeFunc              func(context.Context, *govpsie.StorageCreateRequest) error
+	CreateStorageFunc             func(context.Context, *govpsie.StorageCreateRequest) error
+	DetachAllFromServerFunc       func(context.Context, string, string) error
+	UpdateSizeFunc                func(context.Context, string, string) error
+	UpdateNameFunc                func(context.Context, string, string) error
+	CreateSnapshotFunc            func(context.Context, string, string, string) error
+	ListSnapshotsFunc             func(context.Context, *govpsie.ListOptions) ([]govpsie.StorageSnapShot, error)
+	ListSnapshotsWithResponseFunc func(context.Context, *govpsie.ListOptions) ([]govpsie.StorageSnapShot, *govpsie.Response, error)
+	UpdateSnapshotNameFunc        func(context.Context, string, string) error
+	RollbackSnapshotFunc          func(context.Context, string, string) error
+	CloneSnapshotFunc             func(context.Context, string, string) error
+	DeleteSnapshotFunc            func(context.Context, string) error
+	DeleteAllSnapshotsFunc        func(context.Context, string) error
+	GetFunc                       func(context.Context, string) (*govpsie.StorageDetail, error)
+	ListStorageDataCenterFunc     func(context.Context) ([]govpsie.DataCenter, error)
+}
+
+var _ govpsie.StorageService = (*StorageService)(nil)
+
+func (m *StorageService) List(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.Storage, r1 error) {
+	m.record("List", []interface{}{ctx, options})
+	if m.ListFunc == nil {
+		r1 = notStubbed("StorageService.List")
+		return
+	}
+	return m.ListFunc(ctx, options)
+}
+
+func (m *StorageService) ListWithResponse(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.Storage, r1 *govpsie.Response, r2 error) {
+	m.record("ListWithResponse", []interface{}{ctx, options})
+	if m.ListWithResponseFunc == nil {
+		r2 = notStubbed("StorageService.ListWithResponse")
+		return
+	}
+	return m.ListWithResponseFunc(ctx, options)
+}
+
+func (m *StorageService) Delete(ctx context.Context, storageIdentifier string) (r0 error) {
+	m.record("Delete", []interface{}{ctx, storageIdentifier})
+	if m.DeleteFunc == nil {
+		r0 = notStubbed("StorageService.Delete")
+		return
+	}
+	return m.DeleteFunc(ctx, storageIdentifier)
+}
+
+func (m *StorageService) AttachToServer(ctx context.Context, storageIdentifier string, vmIdentifier string, vmType string) (r0 error) {
+	m.record("AttachToServer", []interface{}{ctx, storageIdentifier, vmIdentifier, vmType})
+	if m.AttachToServerFunc == nil {
+		r0 = notStubbed("StorageService.AttachToServer")
+		return
+	}
+	return m.AttachToServerFunc(ctx, storageIdentifier, vmIdentifier, vmType)
+}
+
+func (m *StorageService) DetachToServer(ctx context.Context, storageIdentifier string, vmIdentifier string, vmType string) (r0 error) {
+	m.record("DetachToServer", []interface{}{ctx, storageIdentifier, vmIdentifier, vmType})
+	if m.DetachToServerFunc == nil {
+		r0 = notStubbed("StorageService.DetachToServer")
+		return
+	}
+	return m.DetachToServerFunc(ctx, storageIdentifier, vmIdentifier, vmType)
+}
+
+func (m *StorageService) CreateContainer(ctx context.Context, dcIdentifier string) (r0 error) {
+	m.record("CreateContainer", []interface{}{ctx, dcIdentifier})
+	if m.CreateContainerFunc == nil {
+		r0 = notStubbed("StorageService.CreateContainer")
+		return
+	}
+	return m.CreateContainerFunc(ctx, dcIdentifier)
+}
+
+func (m *StorageService) ListAll(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.Storage, r1 error) {
+	m.record("ListAll", []interface{}{ctx, options})
+	if m.ListAllFunc == nil {
+		r1 = notStubbed("StorageService.ListAll")
+		return
+	}
+	return m.ListAllFunc(ctx, options)
+}
+
+func (m *StorageService) Create(ctx context.Context, createReq *govpsie.StorageCreateRequest, vmIdentifier string, vmType string) (r0 error) {
+	m.record("Create", []interface{}{ctx, createReq, vmIdentifier, vmType})
+	if m.CreateFunc == nil {
+		r0 = notStubbed("StorageService.Create")
+		return
+	}
+	return m.CreateFunc(ctx, createReq, vmIdentifier, vmType)
+}
+
+func (m *StorageService) ListVmsToAttach(ctx context.Context) (r0 []govpsie.VmToAttach, r1 error) {
+	m.record("ListVmsToAttach", []interface{}{ctx})
+	if m.ListVmsToAttachFunc == nil {
+		r1 = notStubbed("StorageService.ListVmsToAttach")
+		return
+	}
+	return m.ListVmsToAttachFunc(ctx)
+}
+
+func (m *StorageService) CreateVolume(ctx context.Context, creatReq *govpsie.StorageCreateRequest) (r0 error) {
+	m.record("CreateVolume", []interface{}{ctx, creatReq})
+	if m.CreateVolumeFunc == nil {
+		r0 = notStubbed("StorageService.CreateVolume")
+		return
+	}
+	return m.CreateVolumeFunc(ctx, creatReq)
+}
+
+func (m *StorageService) CreateStorage(ctx context.Context, createReq *govpsie.StorageCreateRequest) (r0 error) {
+	m.record("CreateStorage", []interface{}{ctx, createReq})
+	if m.CreateStorageFunc == nil {
+		r0 = notStubbed("StorageService.CreateStorage")
+		return
+	}
+	return m.CreateStorageFunc(ctx, createReq)
+}
+
+func (m *StorageService) DetachAllFromServer(ctx context.Context, vmIdentifier string, vmType string) (r0 error) {
+	m.record("DetachAllFromServer", []interface{}{ctx, vmIdentifier, vmType})
+	if m.DetachAllFromServerFunc == nil {
+		r0 = notStubbed("StorageService.DetachAllFromServer")
+		return
+	}
+	return m.DetachAllFromServerFunc(ctx, vmIdentifier, vmType)
+}
+
+func (m *StorageService) UpdateSize(ctx context.Context, storageIdentifier string, size string) (r0 error) {
+	m.record("UpdateSize", []interface{}{ctx, storageIdentifier, size})
+	if m.UpdateSizeFunc == nil {
+		r0 = notStubbed("StorageService.UpdateSize")
+		return
+	}
+	return m.UpdateSizeFunc(ctx, storageIdentifier, size)
+}
+
+func (m *StorageService) UpdateName(ctx context.Context, storageIdentifier string, name string) (r0 error) {
+	m.record("UpdateName", []interface{}{ctx, storageIdentifier, name})
+	if m.UpdateNameFunc == nil {
+		r0 = notStubbed("StorageService.UpdateName")
+		return
+	}
+	return m.UpdateNameFunc(ctx, storageIdentifier, name)
+}
+
+func (m *StorageService) CreateSnapshot(ctx context.Context, storageIdentifier string, name string, storageType string) (r0 error) {
+	m.record("CreateSnapshot", []interface{}{ctx, storageIdentifier, name, storageType})
+	if m.CreateSnapshotFunc == nil {
+		r0 = notStubbed("StorageService.CreateSnapshot")
+		return
+	}
+	return m.CreateSnapshotFunc(ctx, storageIdentifier, name, storageType)
+}
+
+func (m *StorageService) ListSnapshots(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.StorageSnapShot, r1 error) {
+	m.record("ListSnapshots", []interface{}{ctx, options})
+	if m.ListSnapshotsFunc == nil {
+		r1 = notStubbed("StorageService.ListSnapshots")
+		return
+	}
+	return m.ListSnapshotsFunc(ctx, options)
+}
+
+func (m *StorageService) ListSnapshotsWithResponse(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.StorageSnapShot, r1 *govpsie.Response, r2 error) {
+	m.record("ListSnapshotsWithResponse", []interface{}{ctx, options})
+	if m.ListSnapshotsWithResponseFunc == nil {
+		r2 = notStubbed("StorageService.ListSnapshotsWithResponse")
+		return
+	}
+	return m.ListSnapshotsWithResponseFunc(ctx, options)
+}
+
+func (m *StorageService) UpdateSnapshotName(ctx context.Context, snapshotIdentifier string, name string) (r0 error) {
+	m.record("UpdateSnapshotName", []interface{}{ctx, snapshotIdentifier, name})
+	if m.UpdateSnapshotNameFunc == nil {
+		r0 = notStubbed("StorageService.UpdateSnapshotName")
+		return
+	}
+	return m.UpdateSnapshotNameFunc(ctx, snapshotIdentifier, name)
+}
+
+func (m *StorageService) RollbackSnapshot(ctx context.Context, snapshotIdentifier string, snapType string) (r0 error) {
+	m.record("RollbackSnapshot", []interface{}{ctx, snapshotIdentifier, snapType})
+	if m.RollbackSnapshotFunc == nil {
+		r0 = notStubbed("StorageService.RollbackSnapshot")
+		return
+	}
+	return m.RollbackSnapshotFunc(ctx, snapshotIdentifier, snapType)
+}
+
+func (m *StorageService) CloneSnapshot(ctx context.Context, snapshotIdentifier string, snapType string) (r0 error) {
+	m.record("CloneSnapshot", []interface{}{ctx, snapshotIdentifier, snapType})
+	if m.CloneSnapshotFunc == nil {
+		r0 = notStubbed("StorageService.CloneSnapshot")
+		return
+	}
+	return m.CloneSnapshotFunc(ctx, snapshotIdentifier, snapType)
+}
+
+func (m *StorageService) DeleteSnapshot(ctx context.Context, snapshotIdentifier string) (r0 error) {
+	m.record("DeleteSnapshot", []interface{}{ctx, snapshotIdentifier})
+	if m.DeleteSnapshotFunc == nil {
+		r0 = notStubbed("StorageService.DeleteSnapshot")
+		return
+	}
+	return m.DeleteSnapshotFunc(ctx, snapshotIdentifier)
+}
+
+func (m *StorageService) DeleteAllSnapshots(ctx context.Context, storageIdentifier string) (r0 error) {
+	m.record("DeleteAllSnapshots", []interface{}{ctx, storageIdentifier})
+	if m.DeleteAllSnapshotsFunc == nil {
+		r0 = notStubbed("StorageService.DeleteAllSnapshots")
+		return
+	}
+	return m.DeleteAllSnapshotsFunc(ctx, storageIdentifier)
+}
+
+func (m *StorageService) Get(ctx context.Context, identifier string) (r0 *govpsie.StorageDetail, r1 error) {
+	m.record("Get", []interface{}{ctx, identifier})
+	if m.GetFunc == nil {
+		r1 = notStubbed("StorageService.Get")
+		return
+	}
+	return m.GetFunc(ctx, identifier)
+}
+
+func (m *StorageService) ListStorageDataCenter(ctx context.Context) (r0 []govpsie.DataCenter, r1 error) {
+	m.record("ListStorageDataCenter", []interface{}{ctx})
+	if m.ListStorageDataCenterFunc == nil {
+		r1 = notStubbed("StorageService.ListStorageDataCenter")
+		return
+	}
+	return m.ListStorageDataCenterFunc(ctx)
+}
+
+// TagService is a configurable fake of govpsie.TagService.
+type TagService struct {
+	recorder
+
+	ListFunc               func(context.Context) ([]string, error)
+	GetServerTagsFunc      func(context.Context, string) ([]string, error)
+	SetServerTagsFunc      func(context.Context, string, []string) error
+	AddServerTagsFunc      func(context.Context, string, ...string) error
+	RemoveServerTagsFunc   func(context.Context, string, ...string) error
+	ListResourcesByTagFunc func(context.Context, string) ([]govpsie.TaggedResource, error)
+}
+
+var _ govpsie.TagService = (*TagService)(nil)
+
+func (m *TagService) List(ctx context.Context) (r0 []string, r1 error) {
+	m.record("List", []interface{}{ctx})
+	if m.ListFunc == nil {
+		r1 = notStubbed("TagService.List")
+		return
+	}
+	return m.ListFunc(ctx)
+}
+
+func (m *TagService) GetServerTags(ctx context.Context, vmIdentifier string) (r0 []string, r1 error) {
+	m.record("GetServerTags", []interface{}{ctx, vmIdentifier})
+	if m.GetServerTagsFunc == nil {
+		r1 = notStubbed("TagService.GetServerTags")
+		return
+	}
+	return m.GetServerTagsFunc(ctx, vmIdentifier)
+}
+
+func (m *TagService) SetServerTags(ctx context.Context, vmIdentifier string, tags []string) (r0 error) {
+	m.record("SetServerTags", []interface{}{ctx, vmIdentifier, tags})
+	if m.SetServerTagsFunc == nil {
+		r0 = notStubbed("TagService.SetServerTags")
+		return
+	}
+	return m.SetServerTagsFunc(ctx, vmIdentifier, tags)
+}
+
+func (m *TagService) AddServerTags(ctx context.Context, vmIdentifier string, tags ...string) (r0 error) {
+	m.record("AddServerTags", []interface{}{ctx, vmIdentifier, tags})
+	if m.AddServerTagsFunc == nil {
+		r0 = notStubbed("TagService.AddServerTags")
+		return
+	}
+	return m.AddServerTagsFunc(ctx, vmIdentifier, tags...)
+}
+
+func (m *TagService) RemoveServerTags(ctx context.Context, vmIdentifier string, tags ...string) (r0 error) {
+	m.record("RemoveServerTags", []interface{}{ctx, vmIdentifier, tags})
+	if m.RemoveServerTagsFunc == nil {
+		r0 = notStubbed("TagService.RemoveServerTags")
+		return
+	}
+	return m.RemoveServerTagsFunc(ctx, vmIdentifier, tags...)
+}
+
+func (m *TagService) ListResourcesByTag(ctx context.Context, tag string) (r0 []govpsie.TaggedResource, r1 error) {
+	m.record("ListResourcesByTag", []interface{}{ctx, tag})
+	if m.ListResourcesByTagFunc == nil {
+		r1 = notStubbed("TagService.ListResourcesByTag")
+		return
+	}
+	return m.ListResourcesByTagFunc(ctx, tag)
+}
+
+// VPCService is a configurable fake of govpsie.VPCService.
+type VPCService struct {
+	recorder
+
+	ListFunc             func(context.Context, *govpsie.ListOptions) ([]govpsie.VPC, error)
+	GetFunc              func(context.Context, string) (*govpsie.VPC, error)
+	AssignServerFunc     func(context.Context, *govpsie.AssignServerReq) error
+	MoveServerFunc       func(context.Context, *govpsie.AssignServerReq) error
+	CreateVpcFunc        func(context.Context, *govpsie.CreateVpcReq) error
+	ReleasePrivateIPFunc func(context.Context, string, int) error
+	DeleteVpcFunc        func(context.Context, string, string, string) error
+}
+
+var _ govpsie.VPCService = (*VPCService)(nil)
+
+func (m *VPCService) List(ctx context.Context, options *govpsie.ListOptions) (r0 []govpsie.VPC, r1 error) {
+	m.record("List", []interface{}{ctx, options})
+	if m.ListFunc == nil {
+		r1 = notStubbed("VPCService.List")
+		return
+	}
+	return m.ListFunc(ctx, options)
+}
+
+func (m *VPCService) Get(ctx context.Context, id string) (r0 *govpsie.VPC, r1 error) {
+	m.record("Get", []interface{}{ctx, id})
+	if m.GetFunc == nil {
+		r1 = notStubbed("VPCService.Get")
+		return
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *VPCService) AssignServer(ctx context.Context, assignReq *govpsie.AssignServerReq) (r0 error) {
+	m.record("AssignServer", []interface{}{ctx, assignReq})
+	if m.AssignServerFunc == nil {
+		r0 = notStubbed("VPCService.AssignServer")
+		return
+	}
+	return m.AssignServerFunc(ctx, assignReq)
+}
+
+func (m *VPCService) MoveServer(ctx context.Context, assignReq *govpsie.AssignServerReq) (r0 error) {
+	m.record("MoveServer", []interface{}{ctx, assignReq})
+	if m.MoveServerFunc == nil {
+		r0 = notStubbed("VPCService.MoveServer")
+		return
+	}
+	return m.MoveServerFunc(ctx, assignReq)
+}
+
+func (m *VPCService) CreateVpc(ctx context.Context, createReq *govpsie.CreateVpcReq) (r0 error) {
+	m.record("CreateVpc", []interface{}{ctx, createReq})
+	if m.CreateVpcFunc == nil {
+		r0 = notStubbed("VPCService.CreateVpc")
+		return
+	}
+	return m.CreateVpcFunc(ctx, createReq)
+}
+
+func (m *VPCService) ReleasePrivateIP(ctx context.Context, vmIdentifer string, privateIpId int) (r0 error) {
+	m.record("ReleasePrivateIP", []interface{}{ctx, vmIdentifer, privateIpId})
+	if m.ReleasePrivateIPFunc == nil {
+		r0 = notStubbed("VPCService.ReleasePrivateIP")
+		return
+	}
+	return m.ReleasePrivateIPFunc(ctx, vmIdentifer, privateIpId)
+}
+
+func (m *VPCService) DeleteVpc(ctx context.Context, vpcId string, reason string, note string) (r0 error) {
+	m.record("DeleteVpc", []interface{}{ctx, vpcId, reason, note})
+	if m.DeleteVpcFunc == nil {
+		r0 = notStubbed("VPCService.DeleteVpc")
+		return
+	}
+	return m.DeleteVpcFunc(ctx, vpcId, reason, note)
+}
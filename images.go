@@ -26,7 +26,7 @@ var _ ImagesService = &imagesServiceHandler{}
 type ListCustomImageRoot struct {
 	Error bool          `json:"error"`
 	Data  []CustomImage `json:"data"`
-	Total int           `json:"total "`
+	Total int           `json:"total"`
 }
 
 type CustomImage struct {
@@ -49,7 +49,12 @@ type CustomImage struct {
 }
 
 func (i *imagesServiceHandler) List(ctx context.Context, options *ListOptions) ([]CustomImage, error) {
-	path := fmt.Sprintf("%s/images", imagesPath)
+	ctx = withOperation(ctx, "ImagesService", "List")
+
+	path, err := addOptions(fmt.Sprintf("%s/images", imagesPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := i.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -65,6 +70,8 @@ func (i *imagesServiceHandler) List(ctx context.Context, options *ListOptions) (
 }
 
 func (i *imagesServiceHandler) CreateImages(ctx context.Context, dcIdentifier, imageName, imageUrl string) error {
+	ctx = withOperation(ctx, "ImagesService", "CreateImages")
+
 	path := fmt.Sprintf("%s/images", imagesPath)
 
 	createReq := struct {
@@ -89,6 +96,8 @@ func (i *imagesServiceHandler) CreateImages(ctx context.Context, dcIdentifier, i
 }
 
 func (i *imagesServiceHandler) DeleteImage(ctx context.Context, imageIdentifier string) error {
+	ctx = withOperation(ctx, "ImagesService", "DeleteImage")
+
 	path := fmt.Sprintf("%s/images/%s", imagesPath, imageIdentifier)
 
 	req, err := i.client.NewRequest(ctx, http.MethodDelete, path, nil)
@@ -104,6 +113,8 @@ func (i *imagesServiceHandler) DeleteImage(ctx context.Context, imageIdentifier
 }
 
 func (i *imagesServiceHandler) CreateServerByImage(ctx context.Context, createServerReq *CreateServerRequest) error {
+	ctx = withOperation(ctx, "ImagesService", "CreateServerByImage")
+
 	path := fmt.Sprintf("%s/vm", imagesPath)
 	req, err := i.client.NewRequest(ctx, http.MethodPost, path, createServerReq)
 	if err != nil {
@@ -118,6 +129,8 @@ func (i *imagesServiceHandler) CreateServerByImage(ctx context.Context, createSe
 }
 
 func (i *imagesServiceHandler) GetImage(ctx context.Context, imageIdentifier string) (*CustomImage, error) {
+	ctx = withOperation(ctx, "ImagesService", "GetImage")
+
 	path := fmt.Sprintf("%s/images/?imageIdentifier=%s", imagesPath, imageIdentifier)
 
 	req, err := i.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -0,0 +1,107 @@
+// Package redact masks credentials in API payloads and headers before they
+// are logged or written to disk.
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// Placeholder replaces redacted values.
+const Placeholder = "[REDACTED]"
+
+// sensitiveKeys are the JSON keys, lower-cased, whose values are secrets.
+var sensitiveKeys = map[string]bool{
+	"password":         true,
+	"vmpassword":       true,
+	"newpassword":      true,
+	"oldpassword":      true,
+	"initial_password": true,
+	"secretkey":        true,
+	"private_key":      true,
+	"privatekey":       true,
+	"clientsecret":     true,
+	"token":            true,
+	"accesstoken":      true,
+	"refreshtoken":     true,
+	"ticket":           true,
+}
+
+// sensitiveHeaders are the canonical names of headers carrying credentials.
+var sensitiveHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Vpsie-Auth":          true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+}
+
+// IsSensitiveKey reports whether values of the JSON key are secrets.
+func IsSensitiveKey(key string) bool {
+	return sensitiveKeys[strings.ToLower(key)]
+}
+
+// IsSensitiveHeader reports whether the header carries credentials.
+func IsSensitiveHeader(name string) bool {
+	return sensitiveHeaders[http.CanonicalHeaderKey(name)]
+}
+
+// Header returns a copy of h with the values of sensitive headers replaced.
+func Header(h http.Header) http.Header {
+	out := h.Clone()
+	for name, values := range out {
+		if IsSensitiveHeader(name) {
+			for i := range values {
+				values[i] = Placeholder
+			}
+		}
+	}
+
+	return out
+}
+
+// JSON returns body with the values of sensitive keys replaced, at any
+// depth. Bodies that are not valid JSON are returned unchanged. Token
+// objects, such as {"token":{"accessToken":...}}, are masked as a whole.
+func JSON(body []byte) []byte {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return body
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return body
+	}
+
+	redacted, err := json.Marshal(Value(v))
+	if err != nil {
+		return body
+	}
+
+	return redacted
+}
+
+// Value redacts a value decoded from JSON in place and returns it.
+func Value(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if IsSensitiveKey(key) {
+				v[key] = Placeholder
+				continue
+			}
+			v[key] = Value(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = Value(value)
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,40 @@
+package redact
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestJSON(t *testing.T) {
+	body := []byte(`{"hostname":"web-1","vmPassword":"hunter2","data":[{"secretKey":"s3","accessKey":"a1"}],` +
+		`"token":{"accessToken":"t0k"},"isGeneratedPassword":1,"count":12345678901234567890}`)
+
+	var got, want map[string]interface{}
+	if err := json.Unmarshal(JSON(body), &got); err != nil {
+		t.Fatal(err)
+	}
+	_ = json.Unmarshal([]byte(`{"hostname":"web-1","vmPassword":"[REDACTED]","data":[{"secretKey":"[REDACTED]","accessKey":"a1"}],`+
+		`"token":"[REDACTED]","isGeneratedPassword":1,"count":12345678901234567890}`), &want)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if got := string(JSON([]byte("<html>"))); got != "<html>" {
+		t.Errorf("non-JSON body changed to %q", got)
+	}
+}
+
+func TestHeader(t *testing.T) {
+	h := http.Header{"Vpsie-Auth": {"secret"}, "Accept": {"application/json"}}
+
+	got := Header(h)
+	if got.Get("Vpsie-Auth") != Placeholder || got.Get("Accept") != "application/json" {
+		t.Errorf("got %v", got)
+	}
+	if h.Get("Vpsie-Auth") != "secret" {
+		t.Error("original header was modified")
+	}
+}
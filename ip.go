@@ -12,6 +12,7 @@ type IPsService interface {
 	ListPrivateIPs(ctx context.Context, options *ListOptions) ([]IP, error)
 	ListPublicIPs(ctx context.Context, options *ListOptions) ([]IP, error)
 	ListAllIPs(ctx context.Context, options *ListOptions) ([]IP, error)
+	ListAllIPsWithResponse(ctx context.Context, options *ListOptions) ([]IP, *Response, error)
 	DeleteIP(ctx context.Context, ip, vmIdentifier string) error
 	CreateIps(ctx context.Context, ipType, vmIdentifier string) error
 }
@@ -49,7 +50,12 @@ type IP struct {
 }
 
 func (i *iPsServiceHandler) ListPrivateIPs(ctx context.Context, options *ListOptions) ([]IP, error) {
-	path := fmt.Sprintf("%s/private", ipsPath)
+	ctx = withOperation(ctx, "IPsService", "ListPrivateIPs")
+
+	path, err := addOptions(fmt.Sprintf("%s/private", ipsPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := i.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -65,7 +71,12 @@ func (i *iPsServiceHandler) ListPrivateIPs(ctx context.Context, options *ListOpt
 }
 
 func (i *iPsServiceHandler) ListPublicIPs(ctx context.Context, options *ListOptions) ([]IP, error) {
-	path := fmt.Sprintf("%s/public?offset=%d&limit%d", ipsPath, options.Page, options.PerPage)
+	ctx = withOperation(ctx, "IPsService", "ListPublicIPs")
+
+	path, err := addOptions(fmt.Sprintf("%s/public", ipsPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := i.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -79,21 +90,34 @@ func (i *iPsServiceHandler) ListPublicIPs(ctx context.Context, options *ListOpti
 }
 
 func (i *iPsServiceHandler) ListAllIPs(ctx context.Context, options *ListOptions) ([]IP, error) {
-	path := fmt.Sprintf("%s?offset=%d&limit%d", ipsPath, options.Page, options.PerPage)
+	items, _, err := i.ListAllIPsWithResponse(ctx, options)
+	return items, err
+}
+
+func (i *iPsServiceHandler) ListAllIPsWithResponse(ctx context.Context, options *ListOptions) ([]IP, *Response, error) {
+	ctx = withOperation(ctx, "IPsService", "ListAllIPs")
+
+	path, err := addOptions(ipsPath, options)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := i.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	ips := new(ListIPsRoot)
-	if err = i.client.Do(ctx, req, ips); err != nil {
-		return nil, err
+	resp, err := i.client.DoWithResponse(ctx, req, ips)
+	if err != nil {
+		return nil, resp, err
 
 	}
-	return ips.Data, nil
+	return ips.Data, resp, nil
 }
 
 func (i *iPsServiceHandler) DeleteIP(ctx context.Context, ip, vmIdentifier string) error {
+	ctx = withOperation(ctx, "IPsService", "DeleteIP")
+
 	path := fmt.Sprintf("%s/delete", ipsPath)
 
 	deleteRequest := struct {
@@ -112,6 +136,8 @@ func (i *iPsServiceHandler) DeleteIP(ctx context.Context, ip, vmIdentifier strin
 }
 
 func (i *iPsServiceHandler) CreateIps(ctx context.Context, ipType, vmIdentifier string) error {
+	ctx = withOperation(ctx, "IPsService", "CreateIps")
+
 	path := fmt.Sprintf("%s/add", ipsPath)
 
 	createRequest := struct {
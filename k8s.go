@@ -132,7 +132,12 @@ type CreateK8sGroupReq struct {
 }
 
 func (s *k8sServiceHandler) List(ctx context.Context, options *ListOptions) ([]ListK8s, error) {
-	path := fmt.Sprintf("%s/cluster/all", k8sPath)
+	ctx = withOperation(ctx, "K8sService", "List")
+
+	path, err := addOptions(fmt.Sprintf("%s/cluster/all", k8sPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -148,6 +153,8 @@ func (s *k8sServiceHandler) List(ctx context.Context, options *ListOptions) ([]L
 }
 
 func (s *k8sServiceHandler) Delete(ctx context.Context, identifier, reason, note string) error {
+	ctx = withOperation(ctx, "K8sService", "Delete")
+
 	path := fmt.Sprintf("%s/cluster/byId/%s", k8sPath, identifier)
 
 	deleteStat := struct {
@@ -174,6 +181,8 @@ func (s *k8sServiceHandler) Delete(ctx context.Context, identifier, reason, note
 }
 
 func (s *k8sServiceHandler) Create(ctx context.Context, createReq *CreateK8sReq) error {
+	ctx = withOperation(ctx, "K8sService", "Create")
+
 	path := fmt.Sprintf("%s/create/cluster", k8sPath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, createReq)
@@ -185,6 +194,8 @@ func (s *k8sServiceHandler) Create(ctx context.Context, createReq *CreateK8sReq)
 }
 
 func (s *k8sServiceHandler) Get(ctx context.Context, identifier string) (*K8s, error) {
+	ctx = withOperation(ctx, "K8sService", "Get")
+
 	path := fmt.Sprintf("%s/cluster/byId/%s", k8sPath, identifier)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -201,6 +212,8 @@ func (s *k8sServiceHandler) Get(ctx context.Context, identifier string) (*K8s, e
 }
 
 func (s *k8sServiceHandler) AddSlave(ctx context.Context, identifier string) error {
+	ctx = withOperation(ctx, "K8sService", "AddSlave")
+
 	path := fmt.Sprintf("%s/cluster/byId/%s/add/slave", k8sPath, identifier)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, nil)
@@ -212,6 +225,8 @@ func (s *k8sServiceHandler) AddSlave(ctx context.Context, identifier string) err
 }
 
 func (s *k8sServiceHandler) RemoveSlave(ctx context.Context, identifier string) error {
+	ctx = withOperation(ctx, "K8sService", "RemoveSlave")
+
 	path := fmt.Sprintf("%s/cluster/byId/%s/reduce", k8sPath, identifier)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
@@ -223,6 +238,8 @@ func (s *k8sServiceHandler) RemoveSlave(ctx context.Context, identifier string)
 }
 
 func (s *k8sServiceHandler) ListK8sGroups(ctx context.Context, identifier string) ([]K8sGroup, error) {
+	ctx = withOperation(ctx, "K8sService", "ListK8sGroups")
+
 	path := fmt.Sprintf("%s/node/groups/byClusterId/%s", k8sPath, identifier)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -239,6 +256,8 @@ func (s *k8sServiceHandler) ListK8sGroups(ctx context.Context, identifier string
 }
 
 func (s *k8sServiceHandler) AddNode(ctx context.Context, identifier, nodeType string, groupId int) error {
+	ctx = withOperation(ctx, "K8sService", "AddNode")
+
 	path := fmt.Sprintf("%s/cluster/byId/%s/add/%s/group/%d", k8sPath, identifier, nodeType, groupId)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, nil)
@@ -250,6 +269,8 @@ func (s *k8sServiceHandler) AddNode(ctx context.Context, identifier, nodeType st
 }
 
 func (s *k8sServiceHandler) RemoveNode(ctx context.Context, identifier, nodeType string, groupId int) error {
+	ctx = withOperation(ctx, "K8sService", "RemoveNode")
+
 	path := fmt.Sprintf("%s/cluster/byId/%s/reduce/%s", k8sPath, identifier, nodeType)
 
 	removeStruct := struct {
@@ -267,6 +288,8 @@ func (s *k8sServiceHandler) RemoveNode(ctx context.Context, identifier, nodeType
 }
 
 func (s *k8sServiceHandler) CreateK8sGroup(ctx context.Context, createReq *CreateK8sGroupReq) error {
+	ctx = withOperation(ctx, "K8sService", "CreateK8sGroup")
+
 	path := fmt.Sprintf("%s/cluster/add/group", k8sPath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, &createReq)
@@ -278,6 +301,8 @@ func (s *k8sServiceHandler) CreateK8sGroup(ctx context.Context, createReq *Creat
 }
 
 func (s *k8sServiceHandler) DeleteK8sGroup(ctx context.Context, groupId string, reason, note string) error {
+	ctx = withOperation(ctx, "K8sService", "DeleteK8sGroup")
+
 	path := fmt.Sprintf("%s/cluster/delete/group/%s", k8sPath, groupId)
 
 	deleteReq := struct {
@@ -303,6 +328,8 @@ func (s *k8sServiceHandler) DeleteK8sGroup(ctx context.Context, groupId string,
 }
 
 func (s *k8sServiceHandler) UpgradeK8sVersion(ctx context.Context, identifier string) error {
+	ctx = withOperation(ctx, "K8sService", "UpgradeK8sVersion")
+
 	path := fmt.Sprintf("%s/upgrade/version/cluster/%s", k8sPath, identifier)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, nil)
@@ -314,6 +341,8 @@ func (s *k8sServiceHandler) UpgradeK8sVersion(ctx context.Context, identifier st
 }
 
 func (s *k8sServiceHandler) PatchK8sVersion(ctx context.Context, identifier, processId string) error {
+	ctx = withOperation(ctx, "K8sService", "PatchK8sVersion")
+
 	path := fmt.Sprintf("%s/update/patch/cluster/%s", k8sPath, identifier)
 
 	patchStruct := struct {
@@ -3,7 +3,6 @@ package govpsie
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 	"time"
 )
@@ -12,6 +11,7 @@ var lbPath = "/api/v1/lb"
 
 type LBsService interface {
 	ListLBs(ctx context.Context, options *ListOptions) ([]LB, error)
+	ListLBsWithResponse(ctx context.Context, options *ListOptions) ([]LB, *Response, error)
 	ListLBDataCenters(ctx context.Context, options *ListOptions) ([]LBDataCenter, error)
 	ListOffers(ctx context.Context, dcIdentifier string) ([]LBOffers, error)
 	GetLB(ctx context.Context, lbID string) (*LBDetails, error)
@@ -260,22 +260,35 @@ type PendingLBRoot struct {
 }
 
 func (l *lbsServiceHandler) ListLBs(ctx context.Context, options *ListOptions) ([]LB, error) {
-	path := fmt.Sprintf("%s/all?sortField=created_on&sortDirection=DESC", lbPath)
+	items, _, err := l.ListLBsWithResponse(ctx, options)
+	return items, err
+}
+
+func (l *lbsServiceHandler) ListLBsWithResponse(ctx context.Context, options *ListOptions) ([]LB, *Response, error) {
+	ctx = withOperation(ctx, "LBsService", "ListLBs")
+
+	path, err := addOptions(fmt.Sprintf("%s/all?sortField=created_on&sortDirection=DESC", lbPath), options)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := l.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	listLbsRoot := new(ListLBsRoot)
-	if err := l.client.Do(ctx, req, listLbsRoot); err != nil {
-		return nil, err
+	resp, err := l.client.DoWithResponse(ctx, req, listLbsRoot)
+	if err != nil {
+		return nil, resp, err
 	}
 
-	return listLbsRoot.Data, nil
+	return listLbsRoot.Data, resp, nil
 }
 
 func (l *lbsServiceHandler) GetLB(ctx context.Context, lbID string) (*LBDetails, error) {
+	ctx = withOperation(ctx, "LBsService", "GetLB")
+
 	path := fmt.Sprintf("%s/%s", lbPath, lbID)
 
 	req, err := l.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -292,7 +305,12 @@ func (l *lbsServiceHandler) GetLB(ctx context.Context, lbID string) (*LBDetails,
 }
 
 func (l *lbsServiceHandler) ListLBDataCenters(ctx context.Context, options *ListOptions) ([]LBDataCenter, error) {
-	path := fmt.Sprintf("%s/datacenter", lbPath)
+	ctx = withOperation(ctx, "LBsService", "ListLBDataCenters")
+
+	path, err := addOptions(fmt.Sprintf("%s/datacenter", lbPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := l.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -308,9 +326,10 @@ func (l *lbsServiceHandler) ListLBDataCenters(ctx context.Context, options *List
 }
 
 func (l *lbsServiceHandler) CreateLB(ctx context.Context, createLBReq *CreateLBReq) error {
+	ctx = withOperation(ctx, "LBsService", "CreateLB")
+
 	path := fmt.Sprintf("%s/create", lbPath)
 
-	log.Println("createLBReq", createLBReq)
 	req, err := l.client.NewRequest(ctx, http.MethodPost, path, createLBReq)
 	if err != nil {
 		return err
@@ -320,6 +339,8 @@ func (l *lbsServiceHandler) CreateLB(ctx context.Context, createLBReq *CreateLBR
 }
 
 func (l *lbsServiceHandler) DeleteLB(ctx context.Context, lbID, reason, note string) error {
+	ctx = withOperation(ctx, "LBsService", "DeleteLB")
+
 	path := fmt.Sprintf("%s/%s", lbPath, lbID)
 
 	deleteReq := struct {
@@ -346,6 +367,8 @@ func (l *lbsServiceHandler) DeleteLB(ctx context.Context, lbID, reason, note str
 }
 
 func (l *lbsServiceHandler) AddLBRule(ctx context.Context, addRuleReq *AddRuleReq) error {
+	ctx = withOperation(ctx, "LBsService", "AddLBRule")
+
 	path := fmt.Sprintf("%s/rule/add", lbPath)
 
 	req, err := l.client.NewRequest(ctx, http.MethodPost, path, addRuleReq)
@@ -357,6 +380,8 @@ func (l *lbsServiceHandler) AddLBRule(ctx context.Context, addRuleReq *AddRuleRe
 }
 
 func (l *lbsServiceHandler) DeleteLBRule(ctx context.Context, ruleID string) error {
+	ctx = withOperation(ctx, "LBsService", "DeleteLBRule")
+
 	path := fmt.Sprintf("%s/delete/rule", lbPath)
 	delReq := struct {
 		RuleID string `json:"ruleId"`
@@ -373,6 +398,8 @@ func (l *lbsServiceHandler) DeleteLBRule(ctx context.Context, ruleID string) err
 }
 
 func (l *lbsServiceHandler) AddLBDomain(ctx context.Context, domainAddReq *DomainAddReq) error {
+	ctx = withOperation(ctx, "LBsService", "AddLBDomain")
+
 	path := fmt.Sprintf("%s/domain/add", lbPath)
 
 	req, err := l.client.NewRequest(ctx, http.MethodPost, path, domainAddReq)
@@ -385,6 +412,8 @@ func (l *lbsServiceHandler) AddLBDomain(ctx context.Context, domainAddReq *Domai
 }
 
 func (l *lbsServiceHandler) ReplaceDomain(ctx context.Context, domainId, newDomainId string) error {
+	ctx = withOperation(ctx, "LBsService", "ReplaceDomain")
+
 	path := fmt.Sprintf("%s/domain/replace", lbPath)
 
 	domainReplaceReq := struct {
@@ -404,6 +433,8 @@ func (l *lbsServiceHandler) ReplaceDomain(ctx context.Context, domainId, newDoma
 }
 
 func (l *lbsServiceHandler) UpdateDomainBackend(ctx context.Context, domainId string, backends []Backend) error {
+	ctx = withOperation(ctx, "LBsService", "UpdateDomainBackend")
+
 	path := fmt.Sprintf("%s/backend/update", lbPath)
 
 	updateDomainBackendReq := struct {
@@ -423,6 +454,8 @@ func (l *lbsServiceHandler) UpdateDomainBackend(ctx context.Context, domainId st
 }
 
 func (l *lbsServiceHandler) UpdateLBRules(ctx context.Context, ruleUpdateReq *RuleUpdateReq) error {
+	ctx = withOperation(ctx, "LBsService", "UpdateLBRules")
+
 	path := fmt.Sprintf("%s/rule/update", lbPath)
 
 	req, err := l.client.NewRequest(ctx, http.MethodPost, path, ruleUpdateReq)
@@ -434,6 +467,8 @@ func (l *lbsServiceHandler) UpdateLBRules(ctx context.Context, ruleUpdateReq *Ru
 }
 
 func (l *lbsServiceHandler) UpdateLBDomain(ctx context.Context, domainUpdateReq *DomainUpdateReq) error {
+	ctx = withOperation(ctx, "LBsService", "UpdateLBDomain")
+
 	path := fmt.Sprintf("%s/domain/update", lbPath)
 
 	req, err := l.client.NewRequest(ctx, http.MethodPost, path, domainUpdateReq)
@@ -445,6 +480,8 @@ func (l *lbsServiceHandler) UpdateLBDomain(ctx context.Context, domainUpdateReq
 }
 
 func (l *lbsServiceHandler) DeleteLBDomain(ctx context.Context, domainID string) error {
+	ctx = withOperation(ctx, "LBsService", "DeleteLBDomain")
+
 	path := fmt.Sprintf("%s/delete/domain", lbPath)
 
 	delReq := struct {
@@ -462,6 +499,8 @@ func (l *lbsServiceHandler) DeleteLBDomain(ctx context.Context, domainID string)
 }
 
 func (l *lbsServiceHandler) DeleteLBBackend(ctx context.Context, lbBackendID string) error {
+	ctx = withOperation(ctx, "LBsService", "DeleteLBBackend")
+
 	path := fmt.Sprintf("%s/delete/backend", lbPath)
 
 	delReq := struct {
@@ -479,6 +518,8 @@ func (l *lbsServiceHandler) DeleteLBBackend(ctx context.Context, lbBackendID str
 }
 
 func (l *lbsServiceHandler) ListOffers(ctx context.Context, dcIdentifier string) ([]LBOffers, error) {
+	ctx = withOperation(ctx, "LBsService", "ListOffers")
+
 	path := fmt.Sprintf("%s/offers", lbPath)
 
 	offerReq := struct {
@@ -501,6 +542,8 @@ func (l *lbsServiceHandler) ListOffers(ctx context.Context, dcIdentifier string)
 }
 
 func (l *lbsServiceHandler) ListPendingLBs(ctx context.Context) ([]PendingLB, error) {
+	ctx = withOperation(ctx, "LBsService", "ListPendingLBs")
+
 	path := fmt.Sprint("/api/v2/lbs/pending")
 
 	req, err := l.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -0,0 +1,86 @@
+package govpsie
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/vpsieinc/govpsie/internal/redact"
+)
+
+// maxLogBodyLen caps how much of a body is logged.
+const maxLogBodyLen = 4096
+
+// SetLogger sets the logger used for debug logging of requests and
+// responses. Credentials in headers and bodies are redacted. A nil logger
+// disables logging.
+func (c *Client) SetLogger(logger *slog.Logger) {
+	c.logger = logger
+}
+
+func (c *Client) debugEnabled(ctx context.Context) bool {
+	return c.logger != nil && c.logger.Enabled(ctx, slog.LevelDebug)
+}
+
+func (c *Client) logRequest(ctx context.Context, req *http.Request, attempt int) {
+	if !c.debugEnabled(ctx) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", req.URL.String()),
+		slog.Int("attempt", attempt),
+		slog.Any("headers", redact.Header(req.Header)),
+	}
+	if op, ok := OperationFromContext(ctx); ok {
+		attrs = append(attrs, slog.String("operation", op.String()))
+	}
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			b, _ := io.ReadAll(body)
+			body.Close()
+			if len(b) > 0 {
+				attrs = append(attrs, slog.String("body", logBody(b)))
+			}
+		}
+	}
+
+	c.logger.LogAttrs(ctx, slog.LevelDebug, "vpsie request", attrs...)
+}
+
+func (c *Client) logResponse(ctx context.Context, req *http.Request, res *http.Response, body []byte, elapsed time.Duration, err error) {
+	if !c.debugEnabled(ctx) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", req.URL.String()),
+		slog.Duration("elapsed", elapsed),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		c.logger.LogAttrs(ctx, slog.LevelDebug, "vpsie request failed", attrs...)
+		return
+	}
+
+	attrs = append(attrs,
+		slog.Int("status", res.StatusCode),
+		slog.Any("headers", redact.Header(res.Header)),
+		slog.String("body", logBody(body)),
+	)
+	c.logger.LogAttrs(ctx, slog.LevelDebug, "vpsie response", attrs...)
+}
+
+// logBody redacts and truncates a body for logging.
+func logBody(body []byte) string {
+	s := string(redact.JSON(body))
+	if len(s) > maxLogBodyLen {
+		s = s[:maxLogBodyLen] + "..."
+	}
+
+	return s
+}
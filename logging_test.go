@@ -0,0 +1,34 @@
+package govpsie
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestLoggerRedactsSecrets(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"error":false,"data":{"initial_password":"s3cr3t-out"}}`))
+	})
+	client.SetRequestHeaders(map[string]string{"Vpsie-Auth": "t0ken-hdr"})
+
+	var buf bytes.Buffer
+	client.SetLogger(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+
+	if err := client.Server.ChangePassword(context.Background(), "vm-1", "s3cr3t-in"); err != nil {
+		t.Fatal(err)
+	}
+
+	logs := buf.String()
+	if !strings.Contains(logs, `"msg":"vpsie request"`) || !strings.Contains(logs, `"msg":"vpsie response"`) {
+		t.Fatalf("requests were not logged:\n%s", logs)
+	}
+	for _, secret := range []string{"s3cr3t-in", "s3cr3t-out", "t0ken-hdr"} {
+		if strings.Contains(logs, secret) {
+			t.Errorf("log contains %q:\n%s", secret, logs)
+		}
+	}
+}
@@ -10,8 +10,10 @@ var logsPath = "/apps/v2/logs"
 
 type LogsService interface {
 	ListActivityLogs(ctx context.Context, options *ListOptions) ([]ActivityLog, error)
+	ListActivityLogsWithResponse(ctx context.Context, options *ListOptions) ([]ActivityLog, *Response, error)
 	ListBillingLogs(ctx context.Context, options *ListOptions) ([]BillingLog, error)
 	ListAuditLogs(ctx context.Context, options *ListOptions) ([]AuditLog, error)
+	ListAuditLogsWithResponse(ctx context.Context, options *ListOptions) ([]AuditLog, *Response, error)
 	ListVPSieLogs(ctx context.Context, options *ListOptions) ([]VmLog, error)
 }
 
@@ -99,23 +101,39 @@ type ListVmLogsRoot struct {
 var _ LogsService = &logsServiceHandler{}
 
 func (l *logsServiceHandler) ListActivityLogs(ctx context.Context, options *ListOptions) ([]ActivityLog, error) {
-	path := fmt.Sprintf("%s/activity?offset=%d&limit%d", logsPath, options.Page, options.PerPage)
+	items, _, err := l.ListActivityLogsWithResponse(ctx, options)
+	return items, err
+}
+
+func (l *logsServiceHandler) ListActivityLogsWithResponse(ctx context.Context, options *ListOptions) ([]ActivityLog, *Response, error) {
+	ctx = withOperation(ctx, "LogsService", "ListActivityLogs")
+
+	path, err := addOptions(fmt.Sprintf("%s/activity", logsPath), options)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := l.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	root := new(ListActivityLogsRoot)
-	if err = l.client.Do(ctx, req, root); err != nil {
-		return nil, err
+	resp, err := l.client.DoWithResponse(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
 	}
 
-	return root.Data, nil
+	return root.Data, resp, nil
 }
 
 func (l *logsServiceHandler) ListBillingLogs(ctx context.Context, options *ListOptions) ([]BillingLog, error) {
-	path := fmt.Sprintf("%s/billing?offset=%d&limit%d", logsPath, options.Page, options.PerPage)
+	ctx = withOperation(ctx, "LogsService", "ListBillingLogs")
+
+	path, err := addOptions(fmt.Sprintf("%s/billing", logsPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := l.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -131,22 +149,38 @@ func (l *logsServiceHandler) ListBillingLogs(ctx context.Context, options *ListO
 }
 
 func (l *logsServiceHandler) ListAuditLogs(ctx context.Context, options *ListOptions) ([]AuditLog, error) {
-	path := fmt.Sprintf("%s/audit?offset=%d&limit%d", logsPath, options.Page, options.PerPage)
+	items, _, err := l.ListAuditLogsWithResponse(ctx, options)
+	return items, err
+}
+
+func (l *logsServiceHandler) ListAuditLogsWithResponse(ctx context.Context, options *ListOptions) ([]AuditLog, *Response, error) {
+	ctx = withOperation(ctx, "LogsService", "ListAuditLogs")
+
+	path, err := addOptions(fmt.Sprintf("%s/audit", logsPath), options)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := l.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	root := new(ListAuditLogsRoot)
-	if err = l.client.Do(ctx, req, root); err != nil {
-		return nil, err
+	resp, err := l.client.DoWithResponse(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
 	}
 
-	return root.Data, nil
+	return root.Data, resp, nil
 }
 func (l *logsServiceHandler) ListVPSieLogs(ctx context.Context, options *ListOptions) ([]VmLog, error) {
-	path := fmt.Sprintf("%s/vm?offset=%d&limit%d", logsPath, options.Page, options.PerPage)
+	ctx = withOperation(ctx, "LogsService", "ListVPSieLogs")
+
+	path, err := addOptions(fmt.Sprintf("%s/vm", logsPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := l.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -0,0 +1,111 @@
+package govpsie
+
+import (
+	"net/http"
+	"time"
+)
+
+// Handler performs an API call: it sends req and decodes the response body
+// into v. The request context is available through req.Context().
+type Handler func(req *http.Request, v interface{}) (*Response, error)
+
+// Middleware wraps a Handler. It may modify the request before calling next,
+// inspect or replace the response and error afterwards, or skip next
+// altogether. Middleware sees the whole call, including retries, and the
+// decoded *APIError rather than the raw status.
+type Middleware func(next Handler) Handler
+
+// RequestEditor runs at the end of Client.NewRequest, once the default
+// headers are set, so headers and credentials are part of the request from
+// its creation. Returning an error makes NewRequest fail with it.
+type RequestEditor func(req *http.Request) error
+
+// Hooks are callbacks run around every API call. Nil hooks are skipped.
+type Hooks struct {
+	// OnNewRequest runs when the request is created by NewRequest, see
+	// RequestEditor.
+	OnNewRequest func(req *http.Request) error
+
+	// BeforeRequest runs before the request is sent and may modify it, for
+	// example to add headers. Returning an error aborts the call with it.
+	BeforeRequest func(req *http.Request) error
+
+	// AfterResponse runs once the API answered, with the time the call took.
+	// It runs for error statuses too, before OnError.
+	AfterResponse func(req *http.Request, res *http.Response, elapsed time.Duration)
+
+	// OnError runs when the call failed. res is nil when no response was
+	// received, e.g. on transport errors.
+	OnError func(req *http.Request, res *http.Response, err error)
+}
+
+// Use appends middleware to the chain of the client. The first middleware
+// added is the outermost one. It must not be called concurrently with
+// requests.
+func (c *Client) Use(middleware ...Middleware) {
+	c.middleware = append(c.middleware, middleware...)
+}
+
+// UseRequestEditors appends editors run by NewRequest, in order. It must not
+// be called concurrently with requests.
+func (c *Client) UseRequestEditors(editors ...RequestEditor) {
+	c.requestEditors = append(c.requestEditors, editors...)
+}
+
+// AddHooks registers hooks as a middleware at the end of the chain, and
+// OnNewRequest as a request editor.
+func (c *Client) AddHooks(hooks Hooks) {
+	if hooks.OnNewRequest != nil {
+		c.UseRequestEditors(hooks.OnNewRequest)
+	}
+	c.Use(hooks.middleware)
+}
+
+// editRequest runs the request editors of the client on req.
+func (c *Client) editRequest(req *http.Request) error {
+	for _, edit := range c.requestEditors {
+		if err := edit(req); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// chain wraps h in the middleware of the client.
+func (c *Client) chain(h Handler) Handler {
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		h = c.middleware[i](h)
+	}
+
+	return h
+}
+
+func (hooks Hooks) middleware(next Handler) Handler {
+	return func(req *http.Request, v interface{}) (*Response, error) {
+		if hooks.BeforeRequest != nil {
+			if err := hooks.BeforeRequest(req); err != nil {
+				if hooks.OnError != nil {
+					hooks.OnError(req, nil, err)
+				}
+				return nil, err
+			}
+		}
+
+		start := time.Now()
+		resp, err := next(req, v)
+
+		var res *http.Response
+		if resp != nil {
+			res = resp.HTTPResponse
+		}
+		if res != nil && hooks.AfterResponse != nil {
+			hooks.AfterResponse(req, res, time.Since(start))
+		}
+		if err != nil && hooks.OnError != nil {
+			hooks.OnError(req, res, err)
+		}
+
+		return resp, err
+	}
+}
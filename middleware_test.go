@@ -0,0 +1,100 @@
+package govpsie
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMiddlewareOrderAndHooks(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Tenant") != "t1" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":true,"code":404,"message":"no such vm"}`))
+	})
+
+	var calls []string
+	trace := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(req *http.Request, v interface{}) (*Response, error) {
+				calls = append(calls, name+" before")
+				resp, err := next(req, v)
+				calls = append(calls, name+" after")
+				return resp, err
+			}
+		}
+	}
+	client.Use(trace("outer"), trace("inner"))
+
+	var gotStatus int
+	var gotErr error
+	client.AddHooks(Hooks{
+		BeforeRequest: func(req *http.Request) error {
+			req.Header.Set("X-Tenant", "t1")
+			return nil
+		},
+		AfterResponse: func(req *http.Request, res *http.Response, elapsed time.Duration) {
+			gotStatus = res.StatusCode
+		},
+		OnError: func(req *http.Request, res *http.Response, err error) {
+			gotErr = err
+		},
+	})
+
+	_, err := client.Server.GetServerByIdentifier(context.Background(), "vm-1")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("got error %v, want ErrNotFound", err)
+	}
+
+	want := []string{"outer before", "inner before", "inner after", "outer after"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("got calls %v, want %v", calls, want)
+	}
+	if gotStatus != http.StatusNotFound {
+		t.Errorf("AfterResponse got status %d, want 404", gotStatus)
+	}
+	var apiErr *APIError
+	if !errors.As(gotErr, &apiErr) || apiErr.Message != "no such vm" {
+		t.Errorf("OnError got %v, want the decoded APIError", gotErr)
+	}
+}
+
+func TestRequestEditors(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"error":false}`))
+	})
+
+	var order []string
+	client.UseRequestEditors(func(req *http.Request) error {
+		order = append(order, "editor")
+		req.Header.Set("X-Tenant", "t1")
+		return nil
+	})
+	client.AddHooks(Hooks{OnNewRequest: func(req *http.Request) error {
+		order = append(order, "hook")
+		if req.Header.Get("User-Agent") == "" {
+			t.Error("editor ran before the default headers were set")
+		}
+		return nil
+	}})
+
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "/apps/v2/vm", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Header.Get("X-Tenant") != "t1" || !reflect.DeepEqual(order, []string{"editor", "hook"}) {
+		t.Errorf("header %q, order %v", req.Header.Get("X-Tenant"), order)
+	}
+
+	denied := errors.New("denied")
+	client.UseRequestEditors(func(*http.Request) error { return denied })
+	if _, err := client.NewRequest(context.Background(), http.MethodGet, "/apps/v2/vm", nil); !errors.Is(err, denied) {
+		t.Errorf("err = %v, want %v", err, denied)
+	}
+}
@@ -66,7 +66,12 @@ type CreateMonitoringRuleReq struct {
 }
 
 func (s *monitoringServiceHandler) ListMonitoringRule(ctx context.Context, options *ListOptions) ([]MonitoringRule, error) {
-	path := fmt.Sprintf("%s/rules", monitoringPath)
+	ctx = withOperation(ctx, "MonitoringService", "ListMonitoringRule")
+
+	path, err := addOptions(fmt.Sprintf("%s/rules", monitoringPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -82,6 +87,8 @@ func (s *monitoringServiceHandler) ListMonitoringRule(ctx context.Context, optio
 }
 
 func (s *monitoringServiceHandler) CreateRule(ctx context.Context, createReq *CreateMonitoringRuleReq) error {
+	ctx = withOperation(ctx, "MonitoringService", "CreateRule")
+
 	path := fmt.Sprintf("%s/rules/add", monitoringPath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, &createReq)
@@ -93,6 +100,8 @@ func (s *monitoringServiceHandler) CreateRule(ctx context.Context, createReq *Cr
 }
 
 func (s *monitoringServiceHandler) ToggleMonitoringRuleStatus(ctx context.Context, status, ruleIdentifier string) error {
+	ctx = withOperation(ctx, "MonitoringService", "ToggleMonitoringRuleStatus")
+
 	path := fmt.Sprintf("%s/rules/edit", monitoringPath)
 
 	toggleReq := struct {
@@ -112,6 +121,8 @@ func (s *monitoringServiceHandler) ToggleMonitoringRuleStatus(ctx context.Contex
 }
 
 func (s *monitoringServiceHandler) DeleteMonitoringRule(ctx context.Context, ruleIdentifier string) error {
+	ctx = withOperation(ctx, "MonitoringService", "DeleteMonitoringRule")
+
 	path := fmt.Sprintf("%s/rules/%s", monitoringPath, ruleIdentifier)
 
 
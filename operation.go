@@ -0,0 +1,78 @@
+package govpsie
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Operation identifies the service method that issued a request, e.g.
+// ServerService.CreateServer.
+type Operation struct {
+	Service string
+	Method  string
+}
+
+func (o Operation) String() string {
+	if o.Service == "" {
+		return o.Method
+	}
+
+	return o.Service + "." + o.Method
+}
+
+type operationContextKey struct{}
+
+// WithOperation returns a copy of ctx that carries op.
+func WithOperation(ctx context.Context, op Operation) context.Context {
+	return context.WithValue(ctx, operationContextKey{}, op)
+}
+
+// OperationFromContext returns the operation of a request made by one of the
+// services, as seen by middleware through req.Context().
+func OperationFromContext(ctx context.Context) (Operation, bool) {
+	if op, ok := ctx.Value(operationContextKey{}).(Operation); ok {
+		return op, true
+	}
+
+	op, ok := ctx.Value(serviceOperationContextKey{}).(Operation)
+	return op, ok
+}
+
+type serviceOperationContextKey struct{}
+
+// withOperation records the service method making the requests issued with
+// ctx. Every service method calls it with its own name, so the innermost one
+// wins, while an operation set by the caller with WithOperation takes
+// precedence.
+func withOperation(ctx context.Context, service, method string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return context.WithValue(ctx, serviceOperationContextKey{}, Operation{Service: service, Method: method})
+}
+
+// identifierSegment matches the identifiers the API puts in paths: UUIDs,
+// numeric IDs and long hexadecimal IDs such as process IDs.
+var identifierSegment = regexp.MustCompile(`^(?i:[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}|[0-9]+|[0-9a-f]{24,})$`)
+
+// RouteTemplate returns the path of u with identifiers replaced by {id},
+// e.g. /apps/v2/vm/{id}, together with the last identifier found. UUIDs,
+// numeric and long hexadecimal IDs are identifiers, as are IP addresses and
+// domain names; other segments are kept, so the template of a route does not
+// depend on its arguments.
+func RouteTemplate(u *url.URL) (route, resourceID string) {
+	segments := strings.Split(u.Path, "/")
+	for i, segment := range segments {
+		if !identifierSegment.MatchString(segment) && net.ParseIP(segment) == nil && !strings.Contains(segment, ".") {
+			continue
+		}
+		resourceID = segment
+		segments[i] = "{id}"
+	}
+
+	return strings.Join(segments, "/"), resourceID
+}
@@ -0,0 +1,58 @@
+package govpsie
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestRouteTemplate(t *testing.T) {
+	tests := []struct {
+		path, route, id string
+	}{
+		{"/apps/v2/k8s/cluster/all", "/apps/v2/k8s/cluster/all", ""},
+		{"/apps/v2/k8s/cluster/byId/5b1c2e0a-7d3f-4c1e-9a2b-0c8d7e6f5a4b", "/apps/v2/k8s/cluster/byId/{id}", "5b1c2e0a-7d3f-4c1e-9a2b-0c8d7e6f5a4b"},
+		{"/apps/v2/k8s/cluster/byId/5b1c2e0a-7d3f-4c1e-9a2b-0c8d7e6f5a4b/add/slave", "/apps/v2/k8s/cluster/byId/{id}/add/slave", "5b1c2e0a-7d3f-4c1e-9a2b-0c8d7e6f5a4b"},
+		{"/apps/v2/k8s/cluster/delete/group/42", "/apps/v2/k8s/cluster/delete/group/{id}", "42"},
+		{"/api/v2/vm/status/0f5e9c1d2b3a4f6e7d8c9b0a1f2e3d4c", "/api/v2/vm/status/{id}", "0f5e9c1d2b3a4f6e7d8c9b0a1f2e3d4c"},
+		{"/apps/v2/domain/example.com/records", "/apps/v2/domain/{id}/records", "example.com"},
+		{"/api/v1/lb/all", "/api/v1/lb/all", ""},
+		{"/apps/v2/images/os/ams1", "/apps/v2/images/os/ams1", ""},
+	}
+
+	for _, tt := range tests {
+		route, id := RouteTemplate(&url.URL{Path: tt.path})
+		if route != tt.route || id != tt.id {
+			t.Errorf("RouteTemplate(%q) = %q, %q, want %q, %q", tt.path, route, id, tt.route, tt.id)
+		}
+	}
+}
+
+func TestServiceMethodsRecordTheirOperation(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"error":false,"data":[]}`))
+	})
+
+	var ops []string
+	client.Use(func(next Handler) Handler {
+		return func(req *http.Request, v interface{}) (*Response, error) {
+			op, _ := OperationFromContext(req.Context())
+			ops = append(ops, op.String())
+			return next(req, v)
+		}
+	})
+
+	ctx := context.Background()
+	if _, err := client.Server.List(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.LB.ListLBs(WithOperation(ctx, Operation{Method: "inventory"}), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"ServerService.List", "inventory"}; !reflect.DeepEqual(ops, want) {
+		t.Errorf("operations = %v, want %v", ops, want)
+	}
+}
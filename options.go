@@ -0,0 +1,189 @@
+package govpsie
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// clientOptions collects the settings applied by New.
+type clientOptions struct {
+	baseURL         string
+	token           string
+	clientID        string
+	clientSecret    string
+	httpClient      *http.Client
+	timeout         time.Duration
+	userAgentSuffix string
+	headers         map[string]string
+	retryPolicy     *RetryPolicy
+	retrySet        bool
+	limiter         RateLimiter
+	logger          *slog.Logger
+	middleware      []Middleware
+	requestEditors  []RequestEditor
+}
+
+// Option configures a Client created with New. Options are applied in order,
+// so later options override earlier ones.
+type Option func(*clientOptions) error
+
+// New returns a client configured by opts.
+func New(opts ...Option) (*Client, error) {
+	o := &clientOptions{headers: make(map[string]string)}
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
+	}
+
+	httpClient := o.httpClient
+	if o.timeout > 0 {
+		// Copy, the caller's client or http.DefaultClient must not change.
+		timeoutClient := http.Client{}
+		if httpClient != nil {
+			timeoutClient = *httpClient
+		}
+		timeoutClient.Timeout = o.timeout
+		httpClient = &timeoutClient
+	}
+
+	var c *Client
+	if o.clientID != "" {
+		c = NewClientWithCredentials(httpClient, o.clientID, o.clientSecret)
+	} else {
+		c = NewClient(httpClient)
+	}
+
+	if o.baseURL != "" {
+		if err := c.SetBaseURL(o.baseURL); err != nil {
+			return nil, fmt.Errorf("invalid base URL %q: %w", o.baseURL, err)
+		}
+	}
+	if o.userAgentSuffix != "" {
+		c.UserAgent += " " + o.userAgentSuffix
+	}
+
+	c.SetRequestHeaders(o.headers)
+	if o.token != "" {
+		c.headers[authHeader] = o.token
+	}
+
+	if o.retrySet {
+		c.SetRetryPolicy(o.retryPolicy)
+	}
+	c.SetRateLimiter(o.limiter)
+	c.SetLogger(o.logger)
+	c.Use(o.middleware...)
+	c.UseRequestEditors(o.requestEditors...)
+
+	return c, nil
+}
+
+// WithBaseURL sets the API base URL, e.g. https://api.vpsie.com/apps/v2.
+func WithBaseURL(baseURL string) Option {
+	return func(o *clientOptions) error {
+		o.baseURL = baseURL
+		return nil
+	}
+}
+
+// WithToken authenticates requests with a static access token. It replaces
+// credentials set by an earlier option.
+func WithToken(token string) Option {
+	return func(o *clientOptions) error {
+		o.token = token
+		o.clientID, o.clientSecret = "", ""
+		return nil
+	}
+}
+
+// WithCredentials authenticates requests with tokens obtained by logging in
+// with clientID and clientSecret, see NewClientWithCredentials. It replaces a
+// token set by an earlier option.
+func WithCredentials(clientID, clientSecret string) Option {
+	return func(o *clientOptions) error {
+		if clientID == "" || clientSecret == "" {
+			return fmt.Errorf("client ID and client secret are required")
+		}
+		o.clientID, o.clientSecret = clientID, clientSecret
+		o.token = ""
+		return nil
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to talk to the API.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *clientOptions) error {
+		o.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithTimeout sets the overall timeout of each HTTP request.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *clientOptions) error {
+		o.timeout = timeout
+		return nil
+	}
+}
+
+// WithUserAgentSuffix appends suffix, e.g. "my-cli/1.2", to the user agent.
+func WithUserAgentSuffix(suffix string) Option {
+	return func(o *clientOptions) error {
+		o.userAgentSuffix = suffix
+		return nil
+	}
+}
+
+// WithHeaders adds headers sent with every request.
+func WithHeaders(headers map[string]string) Option {
+	return func(o *clientOptions) error {
+		for k, v := range headers {
+			o.headers[k] = v
+		}
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the retry policy, nil disables retries.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(o *clientOptions) error {
+		o.retryPolicy, o.retrySet = policy, true
+		return nil
+	}
+}
+
+// WithRateLimiter sets a limiter applied to every request.
+func WithRateLimiter(limiter RateLimiter) Option {
+	return func(o *clientOptions) error {
+		o.limiter = limiter
+		return nil
+	}
+}
+
+// WithLogger sets the logger used for debug logging, see Client.SetLogger.
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *clientOptions) error {
+		o.logger = logger
+		return nil
+	}
+}
+
+// WithMiddleware appends middleware to the chain, see Client.Use.
+func WithMiddleware(middleware ...Middleware) Option {
+	return func(o *clientOptions) error {
+		o.middleware = append(o.middleware, middleware...)
+		return nil
+	}
+}
+
+// WithRequestEditors appends editors run by NewRequest, see
+// Client.UseRequestEditors.
+func WithRequestEditors(editors ...RequestEditor) Option {
+	return func(o *clientOptions) error {
+		o.requestEditors = append(o.requestEditors, editors...)
+		return nil
+	}
+}
@@ -0,0 +1,139 @@
+package govpsie
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+var osPath = "/apps/v2/images/os"
+
+// defaultOSArchitecture is preferred by FindOS when an image exists for
+// several architectures.
+const defaultOSArchitecture = "x86_64"
+
+type OSService interface {
+	List(ctx context.Context, dcIdentifier string) ([]OSImage, error)
+	FindOS(ctx context.Context, family, version, dcIdentifier string) (*OSImage, error)
+}
+
+type osServiceHandler struct {
+	client *Client
+}
+
+var _ OSService = &osServiceHandler{}
+
+// OSImage is a stock operating system image. Its Identifier is the
+// OsIdentifier of CreateServerRequest and ResumeReq.
+type OSImage struct {
+	ID           int    `json:"id"`
+	Identifier   string `json:"identifier"`
+	Name         string `json:"name"`
+	Family       string `json:"family"`
+	Version      string `json:"version"`
+	Architecture string `json:"architecture"`
+	Category     string `json:"category"`
+	DcIdentifier string `json:"dcIdentifier"`
+	IsActive     int    `json:"is_active"`
+	MinSsd       int    `json:"min_ssd"`
+	MinRAM       int    `json:"min_ram"`
+}
+
+type ListOSRoot struct {
+	Error bool      `json:"error"`
+	Data  []OSImage `json:"data"`
+	Total int       `json:"total"`
+}
+
+// List lists the stock images available in a data center.
+func (o *osServiceHandler) List(ctx context.Context, dcIdentifier string) ([]OSImage, error) {
+	ctx = withOperation(ctx, "OSService", "List")
+
+	path := fmt.Sprintf("%s/%s", osPath, dcIdentifier)
+	req, err := o.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	images := new(ListOSRoot)
+	if err = o.client.Do(ctx, req, images); err != nil {
+		return nil, err
+	}
+
+	return images.Data, nil
+}
+
+// FindOS returns the active image of family, e.g. "ubuntu", in a data center.
+// version matches exactly or as a dotted prefix, "24" matching "24.04", and
+// the newest match wins; an empty version selects the newest release. x86_64
+// images are preferred over other architectures. The error matches
+// ErrNotFound when nothing matches.
+func (o *osServiceHandler) FindOS(ctx context.Context, family, version, dcIdentifier string) (*OSImage, error) {
+	images, err := o.List(ctx, dcIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *OSImage
+	for i := range images {
+		image := &images[i]
+		if image.IsActive != 1 || !strings.EqualFold(image.Family, family) || !matchVersion(image.Version, version) {
+			continue
+		}
+		if found == nil || betterOSImage(image, found) {
+			found = image
+		}
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("%w: no %s %s image in data center %s", ErrNotFound, family, version, dcIdentifier)
+	}
+
+	return found, nil
+}
+
+// matchVersion reports whether version is want or one of its point releases.
+func matchVersion(version, want string) bool {
+	return want == "" || version == want || strings.HasPrefix(version, want+".")
+}
+
+// betterOSImage reports whether a should be preferred over b.
+func betterOSImage(a, b *OSImage) bool {
+	aDefault := strings.EqualFold(a.Architecture, defaultOSArchitecture)
+	if bDefault := strings.EqualFold(b.Architecture, defaultOSArchitecture); aDefault != bDefault {
+		return aDefault
+	}
+
+	return compareVersions(a.Version, b.Version) > 0
+}
+
+// compareVersions compares dotted versions numerically, falling back to a
+// string comparison for non numeric parts.
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < max(len(as), len(bs)); i++ {
+		var x, y string
+		if i < len(as) {
+			x = as[i]
+		}
+		if i < len(bs) {
+			y = bs[i]
+		}
+
+		xn, xerr := strconv.Atoi(x)
+		yn, yerr := strconv.Atoi(y)
+		switch {
+		case xerr == nil && yerr == nil && xn != yn:
+			if xn < yn {
+				return -1
+			}
+			return 1
+		case (xerr != nil || yerr != nil) && x != y:
+			return strings.Compare(x, y)
+		}
+	}
+
+	return 0
+}
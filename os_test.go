@@ -0,0 +1,70 @@
+package govpsie
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestFindOS(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/apps/v2/images/os/dc-1" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"error":false,"data":[
+			{"identifier":"u2204","family":"Ubuntu","version":"22.04","architecture":"x86_64","is_active":1},
+			{"identifier":"u2404-arm","family":"Ubuntu","version":"24.04","architecture":"aarch64","is_active":1},
+			{"identifier":"u2404","family":"Ubuntu","version":"24.04","architecture":"x86_64","is_active":1},
+			{"identifier":"u2404.1-arm","family":"Ubuntu","version":"24.04.1","architecture":"aarch64","is_active":1},
+			{"identifier":"u2410","family":"Ubuntu","version":"24.10","architecture":"x86_64","is_active":0},
+			{"identifier":"d12","family":"Debian","version":"12","architecture":"x86_64","is_active":1}]}`)
+	})
+
+	tests := []struct {
+		family, version, want string
+	}{
+		{"ubuntu", "24.04", "u2404"},
+		{"ubuntu", "24", "u2404"},
+		{"ubuntu", "22.04", "u2204"},
+		{"ubuntu", "", "u2404"},
+		{"debian", "12", "d12"},
+	}
+
+	for _, tt := range tests {
+		image, err := client.OS.FindOS(context.Background(), tt.family, tt.version, "dc-1")
+		if err != nil {
+			t.Fatalf("FindOS(%q, %q): %v", tt.family, tt.version, err)
+		}
+		if image.Identifier != tt.want {
+			t.Errorf("FindOS(%q, %q) = %s, want %s", tt.family, tt.version, image.Identifier, tt.want)
+		}
+	}
+
+	for _, version := range []string{"24.10", "2"} {
+		if _, err := client.OS.FindOS(context.Background(), "ubuntu", version, "dc-1"); !errors.Is(err, ErrNotFound) {
+			t.Errorf("FindOS(ubuntu, %q) err = %v, want ErrNotFound", version, err)
+		}
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"24.04", "22.04", 1},
+		{"9", "10", -1},
+		{"8.10", "8.9", 1},
+		{"12", "12.0", -1},
+		{"24.04", "24.4", 0},
+		{"stream", "9", 1},
+	}
+
+	for _, tt := range tests {
+		if got := compareVersions(tt.a, tt.b); got != tt.want {
+			t.Errorf("compareVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
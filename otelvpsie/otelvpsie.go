@@ -0,0 +1,198 @@
+// Package otelvpsie instruments a govpsie.Client with OpenTelemetry traces
+// and metrics.
+//
+//	client := govpsie.NewClient(nil)
+//	if err := otelvpsie.Instrument(client); err != nil {
+//		return err
+//	}
+//
+// Every API call then produces a client span named after the service method,
+// e.g. ServerService.CreateServer, and is recorded in the
+// vpsie.client.request.duration histogram and, on failure, in the
+// vpsie.client.request.errors counter.
+//
+// The package is a module of its own, so that programs which do not use it
+// do not depend on OpenTelemetry. Its go.work builds it against the govpsie
+// checkout next to it rather than the release it requires.
+package otelvpsie
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/vpsieinc/govpsie"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const scopeName = "github.com/vpsieinc/govpsie/otelvpsie"
+
+// Attribute keys set on spans and metrics.
+const (
+	HTTPMethodKey = attribute.Key("http.request.method")
+	HTTPStatusKey = attribute.Key("http.response.status_code")
+	RouteKey      = attribute.Key("url.template")
+	ServerKey     = attribute.Key("server.address")
+	ErrorTypeKey  = attribute.Key("error.type")
+	OperationKey  = attribute.Key("vpsie.operation")
+	ResourceIDKey = attribute.Key("vpsie.resource.id")
+	RequestIDKey  = attribute.Key("vpsie.request.id")
+	APIErrorKey   = attribute.Key("vpsie.error.code")
+)
+
+// unknownOperation names calls not made through one of the services.
+const unknownOperation = "unknown"
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	propagators    propagation.TextMapPropagator
+}
+
+// Option configures the instrumentation.
+type Option func(*config)
+
+// WithTracerProvider sets the TracerProvider, the global one by default.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tp
+	}
+}
+
+// WithMeterProvider sets the MeterProvider, the global one by default.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = mp
+	}
+}
+
+// WithPropagators sets the propagators used to inject the trace context into
+// request headers, the global ones by default.
+func WithPropagators(p propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagators = p
+	}
+}
+
+// Instrument adds the instrumentation middleware to client.
+func Instrument(client *govpsie.Client, opts ...Option) error {
+	mw, err := Middleware(opts...)
+	if err != nil {
+		return err
+	}
+
+	client.Use(mw)
+	return nil
+}
+
+// Middleware returns a govpsie.Middleware that traces and measures every
+// call. Add it first so that it covers the other middleware and retries.
+func Middleware(opts ...Option) (govpsie.Middleware, error) {
+	cfg := config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+		propagators:    otel.GetTextMapPropagator(),
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	tracer := cfg.tracerProvider.Tracer(scopeName)
+	meter := cfg.meterProvider.Meter(scopeName)
+
+	duration, err := meter.Float64Histogram("vpsie.client.request.duration",
+		metric.WithUnit("s"),
+		metric.WithDescription("Duration of VPSie API calls, including retries."))
+	if err != nil {
+		return nil, err
+	}
+	errorCount, err := meter.Int64Counter("vpsie.client.request.errors",
+		metric.WithUnit("{error}"),
+		metric.WithDescription("Number of failed VPSie API calls."))
+	if err != nil {
+		return nil, err
+	}
+
+	return func(next govpsie.Handler) govpsie.Handler {
+		return func(req *http.Request, v interface{}) (*govpsie.Response, error) {
+			name := unknownOperation
+			if op, ok := govpsie.OperationFromContext(req.Context()); ok {
+				name = op.String()
+			}
+			route, resourceID := govpsie.RouteTemplate(req.URL)
+
+			attrs := []attribute.KeyValue{
+				OperationKey.String(name),
+				HTTPMethodKey.String(req.Method),
+				RouteKey.String(route),
+				ServerKey.String(req.URL.Hostname()),
+			}
+
+			spanName := name
+			if name == unknownOperation {
+				spanName = req.Method + " " + route
+			}
+			ctx, span := tracer.Start(req.Context(), spanName,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(attrs...))
+			defer span.End()
+			if resourceID != "" {
+				span.SetAttributes(ResourceIDKey.String(resourceID))
+			}
+
+			req = req.WithContext(ctx)
+			cfg.propagators.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+			start := time.Now()
+			resp, err := next(req, v)
+			elapsed := time.Since(start)
+
+			if resp != nil {
+				attrs = append(attrs, HTTPStatusKey.Int(resp.StatusCode))
+				span.SetAttributes(HTTPStatusKey.Int(resp.StatusCode))
+				if resp.RequestID != "" {
+					span.SetAttributes(RequestIDKey.String(resp.RequestID))
+				}
+			}
+
+			if err != nil {
+				errType := errorType(err)
+				attrs = append(attrs, ErrorTypeKey.String(errType))
+
+				span.SetAttributes(ErrorTypeKey.String(errType))
+				var apiErr *govpsie.APIError
+				if errors.As(err, &apiErr) && apiErr.Code != 0 {
+					span.SetAttributes(APIErrorKey.Int(apiErr.Code))
+				}
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+
+				errorCount.Add(ctx, 1, metric.WithAttributes(attrs...))
+			}
+
+			duration.Record(ctx, elapsed.Seconds(), metric.WithAttributes(attrs...))
+
+			return resp, err
+		}
+	}, nil
+}
+
+// errorType classifies err with a low cardinality value.
+func errorType(err error) string {
+	var apiErr *govpsie.APIError
+	if errors.As(err, &apiErr) {
+		// Errors delivered with HTTP 200 carry their status in the body.
+		if apiErr.StatusCode == http.StatusOK && apiErr.Code != 0 {
+			return strconv.Itoa(apiErr.Code)
+		}
+		return strconv.Itoa(apiErr.StatusCode)
+	}
+
+	return "transport"
+}
@@ -0,0 +1,96 @@
+package otelvpsie_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/vpsieinc/govpsie"
+	"github.com/vpsieinc/govpsie/otelvpsie"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestInstrument(t *testing.T) {
+	var traceparent string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("Traceparent")
+		w.Header().Set("X-Request-Id", "req-42")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":true,"code":404,"message":"not found"}`))
+	}))
+	defer srv.Close()
+
+	spans := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	client := govpsie.NewClient(srv.Client())
+	if err := client.SetBaseURL(srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	client.SetRetryPolicy(nil)
+	err := otelvpsie.Instrument(client,
+		otelvpsie.WithTracerProvider(tp),
+		otelvpsie.WithMeterProvider(mp),
+		otelvpsie.WithPropagators(propagation.TraceContext{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.LB.GetLB(context.Background(), "3f1c9a2e-5d7b-4e8a-9c0d-1b2a3f4e5d6c"); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	ended := spans.Ended()
+	if len(ended) != 1 {
+		t.Fatalf("got %d spans, want 1", len(ended))
+	}
+	span := ended[0]
+	if span.Name() != "LBsService.GetLB" {
+		t.Errorf("got span name %q, want LBsService.GetLB", span.Name())
+	}
+	if span.Status().Code != codes.Error {
+		t.Errorf("got span status %v, want Error", span.Status().Code)
+	}
+
+	attrs := attribute.NewSet(span.Attributes()...)
+	for key, want := range map[attribute.Key]attribute.Value{
+		otelvpsie.HTTPMethodKey: attribute.StringValue(http.MethodGet),
+		otelvpsie.RouteKey:      attribute.StringValue("/api/v1/lb/{id}"),
+		otelvpsie.ResourceIDKey: attribute.StringValue("3f1c9a2e-5d7b-4e8a-9c0d-1b2a3f4e5d6c"),
+		otelvpsie.HTTPStatusKey: attribute.IntValue(http.StatusNotFound),
+		otelvpsie.RequestIDKey:  attribute.StringValue("req-42"),
+	} {
+		if got, ok := attrs.Value(key); !ok || got != want {
+			t.Errorf("attribute %s = %v, want %v", key, got.Emit(), want.Emit())
+		}
+	}
+
+	if traceparent == "" {
+		t.Error("trace context was not propagated")
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal(err)
+	}
+	found := map[string]bool{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			found[m.Name] = true
+		}
+	}
+	for _, name := range []string{"vpsie.client.request.duration", "vpsie.client.request.errors"} {
+		if !found[name] {
+			t.Errorf("metric %s not recorded", name)
+		}
+	}
+}
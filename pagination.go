@@ -0,0 +1,178 @@
+package govpsie
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// defaultPerPage is the page size used when ListOptions sets a page but no
+// page size, and by the iterators.
+const defaultPerPage = 50
+
+// addOptions encodes the paging parameters of opt into the query of path,
+// keeping any query parameters path already carries. The API pages with
+// offset and limit, so Page is translated into an item offset.
+func addOptions(path string, opt *ListOptions) (string, error) {
+	if opt == nil || (opt.Page <= 0 && opt.PerPage <= 0) {
+		return path, nil
+	}
+
+	u, err := url.Parse(path)
+	if err != nil {
+		return path, err
+	}
+
+	perPage := opt.PerPage
+	if perPage <= 0 {
+		perPage = defaultPerPage
+	}
+	page := opt.Page
+	if page <= 0 {
+		page = 1
+	}
+
+	q := u.Query()
+	q.Set("offset", strconv.Itoa((page-1)*perPage))
+	q.Set("limit", strconv.Itoa(perPage))
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// PageFunc fetches one page of a collection and returns its items together
+// with the total number of items in the collection, or 0 if unknown.
+type PageFunc[T any] func(ctx context.Context, opt *ListOptions) ([]T, int, error)
+
+// All returns an iterator over every item of a paginated collection, starting
+// at the page in opt. Pages are fetched lazily; iteration stops after the
+// first error, which is yielded with the zero value of T.
+func All[T any](ctx context.Context, opt *ListOptions, fetch PageFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		page := ListOptions{Page: 1, PerPage: defaultPerPage}
+		if opt != nil {
+			page.Page = max(opt.Page, 1)
+			if opt.PerPage > 0 {
+				page.PerPage = opt.PerPage
+			}
+		}
+
+		seen := (page.Page - 1) * page.PerPage
+		for {
+			items, total, err := fetch(ctx, &page)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			seen += len(items)
+
+			// Without a total a short page is the last one. A page larger
+			// than requested means the endpoint ignored paging altogether.
+			if len(items) == 0 || len(items) > page.PerPage || (total > 0 && seen >= total) ||
+				(total == 0 && len(items) < page.PerPage) {
+				return
+			}
+			page.Page++
+		}
+	}
+}
+
+// withTotal adapts a ...WithResponse list method to a PageFunc.
+func withTotal[T any](list func(context.Context, *ListOptions) ([]T, *Response, error)) PageFunc[T] {
+	return func(ctx context.Context, opt *ListOptions) ([]T, int, error) {
+		items, resp, err := list(ctx, opt)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return items, resp.Total, nil
+	}
+}
+
+// AllServers iterates over every server of the account.
+func (c *Client) AllServers(ctx context.Context, opt *ListOptions) iter.Seq2[VmData, error] {
+	return All(ctx, opt, withTotal(c.Server.ListWithResponse))
+}
+
+// AllStorages iterates over every storage volume.
+func (c *Client) AllStorages(ctx context.Context, opt *ListOptions) iter.Seq2[Storage, error] {
+	return All(ctx, opt, withTotal(c.Storage.ListWithResponse))
+}
+
+// AllStorageSnapshots iterates over every storage snapshot.
+func (c *Client) AllStorageSnapshots(ctx context.Context, opt *ListOptions) iter.Seq2[StorageSnapShot, error] {
+	return All(ctx, opt, withTotal(c.Storage.ListSnapshotsWithResponse))
+}
+
+// AllDataCenters iterates over every data center.
+func (c *Client) AllDataCenters(ctx context.Context, opt *ListOptions) iter.Seq2[DataCenter, error] {
+	return All(ctx, opt, withTotal(c.DataCenter.ListWithResponse))
+}
+
+// AllSnapshots iterates over every server snapshot.
+func (c *Client) AllSnapshots(ctx context.Context, opt *ListOptions) iter.Seq2[Snapshot, error] {
+	return All(ctx, opt, withTotal(c.Snapshot.ListWithResponse))
+}
+
+// AllBackups iterates over every backup.
+func (c *Client) AllBackups(ctx context.Context, opt *ListOptions) iter.Seq2[Backup, error] {
+	return All(ctx, opt, withTotal(c.Backup.ListWithResponse))
+}
+
+// AllBuckets iterates over every bucket.
+func (c *Client) AllBuckets(ctx context.Context, opt *ListOptions) iter.Seq2[Bucket, error] {
+	return All(ctx, opt, withTotal(c.Bucket.ListWithResponse))
+}
+
+// AllScripts iterates over every script.
+func (c *Client) AllScripts(ctx context.Context, opt *ListOptions) iter.Seq2[Script, error] {
+	return All(ctx, opt, withTotal(c.Scripts.ListScriptsWithResponse))
+}
+
+// AllDomains iterates over every domain.
+func (c *Client) AllDomains(ctx context.Context, opt *ListOptions) iter.Seq2[Domain, error] {
+	return All(ctx, opt, withTotal(c.Domain.ListDomainsWithResponse))
+}
+
+// AllIPs iterates over every IP address.
+func (c *Client) AllIPs(ctx context.Context, opt *ListOptions) iter.Seq2[IP, error] {
+	return All(ctx, opt, withTotal(c.IP.ListAllIPsWithResponse))
+}
+
+// AllLBs iterates over every load balancer.
+func (c *Client) AllLBs(ctx context.Context, opt *ListOptions) iter.Seq2[LB, error] {
+	return All(ctx, opt, withTotal(c.LB.ListLBsWithResponse))
+}
+
+// AllFirewallGroups iterates over every firewall group.
+func (c *Client) AllFirewallGroups(ctx context.Context, opt *ListOptions) iter.Seq2[FirewallGroupListData, error] {
+	return All(ctx, opt, withTotal(c.FirewallGroup.ListWithResponse))
+}
+
+// AllGateways iterates over every gateway.
+func (c *Client) AllGateways(ctx context.Context, opt *ListOptions) iter.Seq2[Gateway, error] {
+	return All(ctx, opt, withTotal(c.Gateway.ListWithResponse))
+}
+
+// AllProjects iterates over every project.
+func (c *Client) AllProjects(ctx context.Context, opt *ListOptions) iter.Seq2[Project, error] {
+	return All(ctx, opt, withTotal(c.Project.ListWithResponse))
+}
+
+// AllActivityLogs iterates over every activity log entry.
+func (c *Client) AllActivityLogs(ctx context.Context, opt *ListOptions) iter.Seq2[ActivityLog, error] {
+	return All(ctx, opt, withTotal(c.Logs.ListActivityLogsWithResponse))
+}
+
+// AllAuditLogs iterates over every audit log entry.
+func (c *Client) AllAuditLogs(ctx context.Context, opt *ListOptions) iter.Seq2[AuditLog, error] {
+	return All(ctx, opt, withTotal(c.Logs.ListAuditLogsWithResponse))
+}
@@ -0,0 +1,117 @@
+package govpsie
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestAddOptions(t *testing.T) {
+	tests := []struct {
+		path string
+		opt  *ListOptions
+		want string
+	}{
+		{"/apps/v2/domains", nil, "/apps/v2/domains"},
+		{"/apps/v2/domains", &ListOptions{}, "/apps/v2/domains"},
+		{"/apps/v2/domains", &ListOptions{Page: 3, PerPage: 10}, "/apps/v2/domains?limit=10&offset=20"},
+		{"/apps/v2/domains", &ListOptions{PerPage: 10}, "/apps/v2/domains?limit=10&offset=0"},
+		{"/apps/v2/vms?projectId=p1", &ListOptions{Page: 2, PerPage: 5}, "/apps/v2/vms?limit=5&offset=5&projectId=p1"},
+	}
+
+	for _, tt := range tests {
+		got, err := addOptions(tt.path, tt.opt)
+		if err != nil {
+			t.Fatalf("addOptions(%q, %+v): %v", tt.path, tt.opt, err)
+		}
+		if got != tt.want {
+			t.Errorf("addOptions(%q, %+v) = %q, want %q", tt.path, tt.opt, got, tt.want)
+		}
+	}
+}
+
+func TestAllFollowsPages(t *testing.T) {
+	const total = 7
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		rows := ""
+		for i := offset; i < min(offset+limit, total); i++ {
+			if rows != "" {
+				rows += ","
+			}
+			rows += fmt.Sprintf(`{"identifier":"d%d"}`, i)
+		}
+		fmt.Fprintf(w, `{"error":false,"data":[%s],"total":%d}`, rows, total)
+	})
+
+	var ids []string
+	for dc, err := range client.AllDataCenters(context.Background(), &ListOptions{PerPage: 3}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, dc.Identifier)
+	}
+
+	if len(ids) != total || ids[0] != "d0" || ids[total-1] != "d6" {
+		t.Fatalf("got %v, want d0..d6", ids)
+	}
+}
+
+func TestListWithResponse(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-1")
+		_, _ = w.Write([]byte(`{"error":false,"data":{"rows":[{"id":1},{"id":2}],"count":5}}`))
+	})
+
+	gateways, resp, err := client.Gateway.ListWithResponse(context.Background(), &ListOptions{Page: 1, PerPage: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(gateways) != 2 {
+		t.Errorf("got %d gateways, want 2", len(gateways))
+	}
+	if resp.StatusCode != http.StatusOK || resp.RequestID != "req-1" {
+		t.Errorf("got status %d, request id %q", resp.StatusCode, resp.RequestID)
+	}
+	if resp.Total != 5 || resp.NextPage != 2 {
+		t.Errorf("got total %d, next page %d, want 5 and 2", resp.Total, resp.NextPage)
+	}
+}
+
+func TestTagServiceFollowsPages(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		switch r.URL.Path {
+		case "/apps/v2/buckets", "/apps/v2/scripts":
+			// Two items, one per page, the second tagged.
+			if offset == 0 {
+				fmt.Fprint(w, `{"error":false,"data":[{"identifier":"first","tags":[]}],"total":2}`)
+				return
+			}
+			fmt.Fprint(w, `{"error":false,"data":[{"identifier":"second","tags":["late"]}],"total":2}`)
+		case "/apps/v2/gateways/ips":
+			fmt.Fprint(w, `{"error":false,"data":{"rows":[],"count":0}}`)
+		default:
+			fmt.Fprint(w, `{"error":false,"data":[],"total":0}`)
+		}
+	})
+
+	resources, err := client.Tag.ListResourcesByTag(context.Background(), "late")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, r := range resources {
+		got = append(got, r.Type+"/"+r.Identifier)
+	}
+	if fmt.Sprint(got) != fmt.Sprint([]string{ResourceBucket + "/second", ResourceScript + "/second"}) {
+		t.Errorf("ListResourcesByTag = %v", got)
+	}
+}
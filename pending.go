@@ -58,6 +58,8 @@ type PendingVm struct {
 }
 
 func (p *pendingServiceHandler) GetPendingVms(ctx context.Context) ([]PendingVm, error) {
+	ctx = withOperation(ctx, "PendingService", "GetPendingVms")
+
 	path := fmt.Sprintf("%s/pending", pendingBasePath)
 	req, err := p.client.NewRequest(ctx, "GET", path, nil)
 	if err != nil {
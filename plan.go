@@ -0,0 +1,62 @@
+package govpsie
+
+import (
+	"errors"
+	"sort"
+)
+
+// ErrNoPlan is returned by SelectResourcePlan when no plan meets the
+// requirements.
+var ErrNoPlan = errors.New("vpsie: no resource plan meets the requirements")
+
+// PlanRequirements are the minimum resources of a plan, in the units of
+// ResourcePlan. Zero values are not constrained.
+type PlanRequirements struct {
+	MinCPU      int
+	MinRAM      int
+	MinSsd      int
+	MinTraffic  int
+	MinNetSpeed int
+}
+
+// Satisfied reports whether plan meets r.
+func (r PlanRequirements) Satisfied(plan ResourcePlan) bool {
+	return plan.CPU >= r.MinCPU &&
+		plan.RAM >= r.MinRAM &&
+		plan.Ssd >= r.MinSsd &&
+		plan.Traffic >= r.MinTraffic &&
+		plan.NetSpeed >= r.MinNetSpeed
+}
+
+// SelectResourcePlan returns the cheapest plan meeting r, as listed by
+// ServerService.ListResourcePlans. Ties go to the smallest plan. Its
+// Identifier is the ResourceIdentifier of CreateServerRequest and
+// CreateK8sReq.
+func SelectResourcePlan(plans []ResourcePlan, r PlanRequirements) (*ResourcePlan, error) {
+	var candidates []ResourcePlan
+	for _, plan := range plans {
+		if r.Satisfied(plan) {
+			candidates = append(candidates, plan)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, ErrNoPlan
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		switch {
+		case a.Price != b.Price:
+			return a.Price < b.Price
+		case a.CPU != b.CPU:
+			return a.CPU < b.CPU
+		case a.RAM != b.RAM:
+			return a.RAM < b.RAM
+		case a.Ssd != b.Ssd:
+			return a.Ssd < b.Ssd
+		}
+		return a.Identifier < b.Identifier
+	})
+
+	return &candidates[0], nil
+}
@@ -0,0 +1,41 @@
+package govpsie
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSelectResourcePlan(t *testing.T) {
+	plans := []ResourcePlan{
+		{Identifier: "large", CPU: 4, RAM: 8192, Ssd: 160, Traffic: 5, NetSpeed: 1000, Price: 40},
+		{Identifier: "small", CPU: 1, RAM: 1024, Ssd: 25, Traffic: 1, NetSpeed: 100, Price: 5},
+		{Identifier: "medium", CPU: 2, RAM: 4096, Ssd: 80, Traffic: 3, NetSpeed: 1000, Price: 20},
+		{Identifier: "medium-sata", CPU: 2, RAM: 4096, Ssd: 40, Traffic: 3, NetSpeed: 1000, Price: 20},
+	}
+
+	tests := []struct {
+		req  PlanRequirements
+		want string
+	}{
+		{PlanRequirements{}, "small"},
+		{PlanRequirements{MinCPU: 2}, "medium-sata"},
+		{PlanRequirements{MinCPU: 2, MinSsd: 60}, "medium"},
+		{PlanRequirements{MinNetSpeed: 1000}, "medium-sata"},
+		{PlanRequirements{MinRAM: 6000}, "large"},
+		{PlanRequirements{MinTraffic: 4}, "large"},
+	}
+
+	for _, tt := range tests {
+		plan, err := SelectResourcePlan(plans, tt.req)
+		if err != nil {
+			t.Fatalf("SelectResourcePlan(%+v): %v", tt.req, err)
+		}
+		if plan.Identifier != tt.want {
+			t.Errorf("SelectResourcePlan(%+v) = %s, want %s", tt.req, plan.Identifier, tt.want)
+		}
+	}
+
+	if _, err := SelectResourcePlan(plans, PlanRequirements{MinCPU: 16}); !errors.Is(err, ErrNoPlan) {
+		t.Errorf("err = %v, want ErrNoPlan", err)
+	}
+}
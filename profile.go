@@ -34,7 +34,7 @@ var _ ProfilesService = &profilesServiceHandler{}
 type ListActionOfUserRoot struct {
 	Error bool           `json:"error"`
 	Data  []QuickActions `json:"data"`
-	Total int            `json:"total "`
+	Total int            `json:"total"`
 }
 
 type QuickActions struct {
@@ -99,7 +99,12 @@ type UpdateProfileRequest struct {
 }
 
 func (p *profilesServiceHandler) ListQuickActionOfUser(ctx context.Context, options *ListOptions) ([]QuickActions, error) {
-	path := fmt.Sprintf("%s/user/quick/actions", profilePath)
+	ctx = withOperation(ctx, "ProfilesService", "ListQuickActionOfUser")
+
+	path, err := addOptions(fmt.Sprintf("%s/user/quick/actions", profilePath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := p.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -115,7 +120,12 @@ func (p *profilesServiceHandler) ListQuickActionOfUser(ctx context.Context, opti
 }
 
 func (p *profilesServiceHandler) ListQuickActionOfAccount(ctx context.Context, options *ListOptions) ([]QuickActions, error) {
-	path := fmt.Sprintf("%s/quick/actions", profilePath)
+	ctx = withOperation(ctx, "ProfilesService", "ListQuickActionOfAccount")
+
+	path, err := addOptions(fmt.Sprintf("%s/quick/actions", profilePath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := p.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -131,6 +141,8 @@ func (p *profilesServiceHandler) ListQuickActionOfAccount(ctx context.Context, o
 }
 
 func (p *profilesServiceHandler) SaveQuickActions(ctx context.Context, actions []int) error {
+	ctx = withOperation(ctx, "ProfilesService", "SaveQuickActions")
+
 	path := fmt.Sprintf("%s/quick/actions/save", profilePath)
 
 	req, err := p.client.NewRequest(ctx, http.MethodPut, path, actions)
@@ -141,6 +153,8 @@ func (p *profilesServiceHandler) SaveQuickActions(ctx context.Context, actions [
 	return p.client.Do(ctx, req, nil)
 }
 func (p *profilesServiceHandler) GetProfile(ctx context.Context) (*Profile, error) {
+	ctx = withOperation(ctx, "ProfilesService", "GetProfile")
+
 	path := fmt.Sprintf("%s/user", profilePath)
 
 	req, err := p.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -154,6 +168,8 @@ func (p *profilesServiceHandler) GetProfile(ctx context.Context) (*Profile, erro
 	return &profile.Data, nil
 }
 func (p *profilesServiceHandler) UpdateProfile(ctx context.Context, updateReq UpdateProfileRequest) error {
+	ctx = withOperation(ctx, "ProfilesService", "UpdateProfile")
+
 	path := fmt.Sprintf("%s/user", profilePath)
 
 	req, err := p.client.NewRequest(context.TODO(), http.MethodPut, path, updateReq)
@@ -164,6 +180,8 @@ func (p *profilesServiceHandler) UpdateProfile(ctx context.Context, updateReq Up
 	return p.client.Do(ctx, req, nil)
 }
 func (p *profilesServiceHandler) GetPermissionGroups(ctx context.Context) ([]PermissionGroup, error) {
+	ctx = withOperation(ctx, "ProfilesService", "GetPermissionGroups")
+
 	path := fmt.Sprintf("%s/permission/group", profilePath)
 
 	req, err := p.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -180,6 +198,8 @@ func (p *profilesServiceHandler) GetPermissionGroups(ctx context.Context) ([]Per
 }
 
 func (p *profilesServiceHandler) DeletePermissionGroup(ctx context.Context, groupId string) error {
+	ctx = withOperation(ctx, "ProfilesService", "DeletePermissionGroup")
+
 	path := fmt.Sprintf("%s/permission/group/%s", profilePath, groupId)
 
 	req, err := p.client.NewRequest(ctx, http.MethodDelete, path, nil)
@@ -191,6 +211,8 @@ func (p *profilesServiceHandler) DeletePermissionGroup(ctx context.Context, grou
 }
 
 func (p *profilesServiceHandler) CreatePermissionGroup(ctx context.Context, groupName string) error {
+	ctx = withOperation(ctx, "ProfilesService", "CreatePermissionGroup")
+
 	path := fmt.Sprintf("%s/permission/group", profilePath)
 	createReq := struct {
 		GroupName string `json:"groupName"`
@@ -207,6 +229,8 @@ func (p *profilesServiceHandler) CreatePermissionGroup(ctx context.Context, grou
 }
 
 func (p *profilesServiceHandler) ChangePassword(ctx context.Context, oldPassword string, newPassword string) error {
+	ctx = withOperation(ctx, "ProfilesService", "ChangePassword")
+
 	changePassReq := struct {
 		OldPassword string `json:"oldPassword"`
 		NewPassword string `json:"newPassword"`
@@ -226,6 +250,8 @@ func (p *profilesServiceHandler) ChangePassword(ctx context.Context, oldPassword
 }
 
 func (p *profilesServiceHandler) UpdateBilling(ctx context.Context, billing BillingAddress) error {
+	ctx = withOperation(ctx, "ProfilesService", "UpdateBilling")
+
 	path := fmt.Sprintf("%s/billing", profilePath)
 
 	req, err := p.client.NewRequest(ctx, http.MethodPut, path, billing)
@@ -238,6 +264,8 @@ func (p *profilesServiceHandler) UpdateBilling(ctx context.Context, billing Bill
 }
 
 func (p *profilesServiceHandler) ValidatePhone(ctx context.Context, phone string) error {
+	ctx = withOperation(ctx, "ProfilesService", "ValidatePhone")
+
 	path := fmt.Sprintf("%s/phone/validate", profilePath)
 	validateReq := struct {
 		PhoneNumber string `json:"phoneNumber"`
@@ -254,6 +282,8 @@ func (p *profilesServiceHandler) ValidatePhone(ctx context.Context, phone string
 }
 
 func (p *profilesServiceHandler) VerifyPhone(ctx context.Context, code string) error {
+	ctx = withOperation(ctx, "ProfilesService", "VerifyPhone")
+
 	path := fmt.Sprintf("%s/phone/verify", profilePath)
 	verifyReq := struct {
 		Code string `json:"code"`
@@ -270,6 +300,8 @@ func (p *profilesServiceHandler) VerifyPhone(ctx context.Context, code string) e
 }
 
 func (p *profilesServiceHandler) EnableTwofa(ctx context.Context) error {
+	ctx = withOperation(ctx, "ProfilesService", "EnableTwofa")
+
 	path := fmt.Sprintf("%s/twoFa/enable", profilePath)
 
 	req, err := p.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -281,6 +313,8 @@ func (p *profilesServiceHandler) EnableTwofa(ctx context.Context) error {
 }
 
 func (p *profilesServiceHandler) DisableTwofa(ctx context.Context) error {
+	ctx = withOperation(ctx, "ProfilesService", "DisableTwofa")
+
 	path := fmt.Sprintf("%s/twoFa/disable", profilePath)
 
 	req, err := p.client.NewRequest(ctx, http.MethodPost, path, nil)
@@ -10,6 +10,7 @@ var projectsBasePath = "/apps/v2/projects"
 
 type ProjectsService interface {
 	List(context.Context, *ListOptions) ([]Project, error)
+	ListWithResponse(context.Context, *ListOptions) ([]Project, *Response, error)
 	SetDefault(context.Context, string) error
 	Get(ctx context.Context, identifer string) (*Project, error)
 	Create(context.Context, *CreateProjectRequest) error
@@ -74,20 +75,35 @@ type UserLimit struct {
 }
 
 func (p *projectsServiceHandler) List(ctx context.Context, options *ListOptions) ([]Project, error) {
-	req, err := p.client.NewRequest(ctx, http.MethodGet, projectsBasePath, nil)
+	items, _, err := p.ListWithResponse(ctx, options)
+	return items, err
+}
+
+func (p *projectsServiceHandler) ListWithResponse(ctx context.Context, options *ListOptions) ([]Project, *Response, error) {
+	ctx = withOperation(ctx, "ProjectsService", "List")
+
+	path, err := addOptions(projectsBasePath, options)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	req, err := p.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	projects := new(ProjectsRoot)
-	if err = p.client.Do(ctx, req, projects); err != nil {
-		return nil, err
+	resp, err := p.client.DoWithResponse(ctx, req, projects)
+	if err != nil {
+		return nil, resp, err
 	}
 
-	return projects.Data.Rows, nil
+	return projects.Data.Rows, resp, nil
 }
 
 func (p *projectsServiceHandler) SetDefault(ctx context.Context, projectIdentifier string) error {
+	ctx = withOperation(ctx, "ProjectsService", "SetDefault")
+
 	path := fmt.Sprintf("%s/set/default", projectsBasePath)
 
 	projectReq := struct {
@@ -104,6 +120,8 @@ func (p *projectsServiceHandler) SetDefault(ctx context.Context, projectIdentifi
 }
 
 func (p *projectsServiceHandler) Get(ctx context.Context, identifer string) (*Project, error) {
+	ctx = withOperation(ctx, "ProjectsService", "Get")
+
 	path := fmt.Sprintf("%s/%s", projectsBasePath, identifer)
 	req, err := p.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -119,6 +137,8 @@ func (p *projectsServiceHandler) Get(ctx context.Context, identifer string) (*Pr
 }
 
 func (p *projectsServiceHandler) Create(ctx context.Context, projectReq *CreateProjectRequest) error {
+	ctx = withOperation(ctx, "ProjectsService", "Create")
+
 	path := fmt.Sprintf("%s/add", projectsBasePath)
 
 	req, err := p.client.NewRequest(ctx, http.MethodPost, path, projectReq)
@@ -130,6 +150,8 @@ func (p *projectsServiceHandler) Create(ctx context.Context, projectReq *CreateP
 }
 
 func (p *projectsServiceHandler) ListAnotherVms(ctx context.Context, projectId string) ([]VmData, error) {
+	ctx = withOperation(ctx, "ProjectsService", "ListAnotherVms")
+
 	path := fmt.Sprintf("%s/another/vms?projectId=%s", projectsBasePath, projectId)
 
 	vms := new(ListServerRoot)
@@ -146,6 +168,8 @@ func (p *projectsServiceHandler) ListAnotherVms(ctx context.Context, projectId s
 }
 
 func (p *projectsServiceHandler) MoveVms(ctx context.Context, projectIdentifier, projectId string) error {
+	ctx = withOperation(ctx, "ProjectsService", "MoveVms")
+
 	path := fmt.Sprintf("%s/move/vms", projectsBasePath)
 
 	moveReq := struct {
@@ -164,6 +188,8 @@ func (p *projectsServiceHandler) MoveVms(ctx context.Context, projectIdentifier,
 }
 
 func (p *projectsServiceHandler) AssignToVms(ctx context.Context, projectIdentifier, projectId string) error {
+	ctx = withOperation(ctx, "ProjectsService", "AssignToVms")
+
 	path := fmt.Sprintf("%s/vm", projectsBasePath)
 
 	assignReq := struct {
@@ -183,6 +209,8 @@ func (p *projectsServiceHandler) AssignToVms(ctx context.Context, projectIdentif
 }
 
 func (p *projectsServiceHandler) ListDomains(ctx context.Context, projectIdentifier string) ([]Domain, error) {
+	ctx = withOperation(ctx, "ProjectsService", "ListDomains")
+
 	path := fmt.Sprintf("/apps/v2/domains/project/%s", projectIdentifier)
 
 	domains := new(ListDomainRoot)
@@ -199,6 +227,8 @@ func (p *projectsServiceHandler) ListDomains(ctx context.Context, projectIdentif
 }
 
 func (p *projectsServiceHandler) Delete(ctx context.Context, id string) error {
+	ctx = withOperation(ctx, "ProjectsService", "Delete")
+
 	path := fmt.Sprintf("%s/%s", projectsBasePath, id)
 
 	req, err := p.client.NewRequest(ctx, http.MethodDelete, path, nil)
@@ -210,6 +240,8 @@ func (p *projectsServiceHandler) Delete(ctx context.Context, id string) error {
 }
 
 func (p *projectsServiceHandler) ListUserLimits(ctx context.Context) (*UserLimit, error) {
+	ctx = withOperation(ctx, "ProjectsService", "ListUserLimits")
+
 	path := "/apps/v2/profile/product/limits"
 
 	req, err := p.client.NewRequest(ctx, http.MethodGet, path, nil)
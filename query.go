@@ -0,0 +1,311 @@
+package govpsie
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ParseServerFilter parses a textual filter such as
+//
+//	dc=ams1 and power=1
+//	tag=prod and (state=running or cpu>=4) and not hostname~'test-*'
+//
+// Comparisons are field op value, where field is a VmData JSON name or an
+// alias listed by ServerFields and op is one of = != < <= > >= and ~ (glob
+// match). Text compares ignoring case, numeric fields numerically, and
+// tags=x holds for servers tagged x. Comparisons combine with and, or, not
+// and parentheses; juxtaposed comparisons are and-ed. An empty query matches
+// every server.
+func ParseServerFilter(query string) (ServerFilter, error) {
+	tokens, err := lexFilter(query)
+	if err != nil {
+		return nil, err
+	}
+	if tokens[0].kind == tokenEOF {
+		return Where(), nil
+	}
+
+	p := &filterParser{tokens: tokens}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, p.errorf(t, "unexpected %q", t.text)
+	}
+
+	return f, nil
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOp
+	tokenLParen
+	tokenRParen
+)
+
+type filterToken struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func lexFilter(query string) ([]filterToken, error) {
+	var tokens []filterToken
+
+	for i := 0; i < len(query); {
+		c := query[i]
+		r, size := utf8.DecodeRuneInString(query[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+		case c == '(':
+			tokens = append(tokens, filterToken{tokenLParen, "(", i})
+			i++
+		case c == ')':
+			tokens = append(tokens, filterToken{tokenRParen, ")", i})
+			i++
+		case strings.ContainsRune("=!<>~", rune(c)):
+			op := string(c)
+			if i+1 < len(query) && query[i+1] == '=' && c != '=' && c != '~' {
+				op += "="
+			}
+			if op == "!" {
+				return nil, fmt.Errorf("vpsie: filter: unexpected ! at offset %d", i)
+			}
+			tokens = append(tokens, filterToken{tokenOp, op, i})
+			i += len(op)
+		case c == '"' || c == '\'':
+			var b strings.Builder
+			j := i + 1
+			for ; j < len(query) && query[j] != c; j++ {
+				if query[j] == '\\' && j+1 < len(query) {
+					j++
+				}
+				b.WriteByte(query[j])
+			}
+			if j == len(query) {
+				return nil, fmt.Errorf("vpsie: filter: unterminated string at offset %d", i)
+			}
+			tokens = append(tokens, filterToken{tokenString, b.String(), i})
+			i = j + 1
+		default:
+			j := i
+			for j < len(query) {
+				r, size := utf8.DecodeRuneInString(query[j:])
+				if unicode.IsSpace(r) || strings.ContainsRune("()=!<>~\"'", r) {
+					break
+				}
+				j += size
+			}
+			if j == i {
+				return nil, fmt.Errorf("vpsie: filter: unexpected %q at offset %d", r, i)
+			}
+			tokens = append(tokens, filterToken{tokenWord, query[i:j], i})
+			i = j
+		}
+	}
+
+	return append(tokens, filterToken{kind: tokenEOF, pos: len(query)}), nil
+}
+
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+func (p *filterParser) peek() filterToken {
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() filterToken {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *filterParser) keyword(t filterToken, word string) bool {
+	return t.kind == tokenWord && strings.EqualFold(t.text, word)
+}
+
+func (p *filterParser) errorf(t filterToken, format string, args ...interface{}) error {
+	return fmt.Errorf("vpsie: filter: %s at offset %d", fmt.Sprintf(format, args...), t.pos)
+}
+
+func (p *filterParser) parseOr() (ServerFilter, error) {
+	f, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.keyword(p.peek(), "or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		f = AnyOf(f, right)
+	}
+
+	return f, nil
+}
+
+func (p *filterParser) parseAnd() (ServerFilter, error) {
+	f, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		t := p.peek()
+		switch {
+		case p.keyword(t, "and"):
+			p.next()
+		case t.kind == tokenWord && !p.keyword(t, "or"), t.kind == tokenLParen:
+			// Juxtaposed comparisons are and-ed.
+		default:
+			return f, nil
+		}
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		f = Where(f, right)
+	}
+}
+
+func (p *filterParser) parseUnary() (ServerFilter, error) {
+	t := p.next()
+	switch {
+	case p.keyword(t, "not"):
+		f, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return Not(f), nil
+	case t.kind == tokenLParen:
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.next(); t.kind != tokenRParen {
+			return nil, p.errorf(t, "missing )")
+		}
+		return f, nil
+	case t.kind == tokenWord:
+		return p.parseComparison(t)
+	case t.kind == tokenEOF:
+		return nil, p.errorf(t, "unexpected end of filter")
+	}
+
+	return nil, p.errorf(t, "unexpected %q", t.text)
+}
+
+func (p *filterParser) parseComparison(field filterToken) (ServerFilter, error) {
+	index, err := serverField(field.text)
+	if err != nil {
+		return nil, p.errorf(field, "unknown field %q", field.text)
+	}
+
+	op := p.next()
+	if op.kind != tokenOp {
+		return nil, p.errorf(op, "expected operator after %s", field.text)
+	}
+	value := p.next()
+	if value.kind != tokenWord && value.kind != tokenString {
+		return nil, p.errorf(value, "expected value after %s%s", field.text, op.text)
+	}
+
+	typ := reflect.TypeOf(VmData{}).FieldByIndex(index).Type
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	switch {
+	case typ == reflect.TypeOf(TagList(nil)):
+		return tagComparison(op, value.text)
+	case typ.Kind() >= reflect.Int && typ.Kind() <= reflect.Int64 && op.text != "~":
+		n, err := strconv.ParseInt(value.text, 10, 64)
+		if err != nil {
+			return nil, p.errorf(value, "%s expects a number, got %q", field.text, value.text)
+		}
+		return func(vm *VmData) bool {
+			v := fieldValue(vm, index)
+			if !v.IsValid() {
+				return op.text == "!="
+			}
+			return compareOp(op.text, cmpOrdered(v.Int(), n))
+		}, nil
+	}
+
+	// state goes through serverState, like StateIs.
+	stateIndex, _ := serverField("state")
+	isState := slices.Equal(index, stateIndex)
+
+	want := value.text
+	return func(vm *VmData) bool {
+		v := fieldValue(vm, index)
+		s := ""
+		if isState {
+			s = string(serverState(vm, nil))
+		} else if v.IsValid() {
+			s = fieldString(v)
+		}
+		if op.text == "~" {
+			return globMatch(want, s)
+		}
+		return compareOp(op.text, strings.Compare(strings.ToLower(s), strings.ToLower(want)))
+	}, nil
+}
+
+func tagComparison(op filterToken, tag string) (ServerFilter, error) {
+	switch op.text {
+	case "=":
+		return Tag(tag), nil
+	case "!=":
+		return Not(Tag(tag)), nil
+	case "~":
+		return func(vm *VmData) bool {
+			for _, name := range vm.Tags {
+				if globMatch(tag, name) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	}
+
+	return nil, fmt.Errorf("vpsie: filter: tags do not support %s at offset %d", op.text, op.pos)
+}
+
+// compareOp applies op to the result of a three-way comparison.
+func compareOp(op string, c int) bool {
+	switch op {
+	case "=":
+		return c == 0
+	case "!=":
+		return c != 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+
+	return false
+}
@@ -0,0 +1,221 @@
+package govpsie
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimiter throttles outgoing requests. Wait blocks until a request may be
+// sent or ctx is done.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// TokenBucket is a RateLimiter that refills at a steady rate and allows bursts
+// up to its capacity. It is safe for concurrent use.
+type TokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+var _ RateLimiter = &TokenBucket{}
+
+// NewTokenBucket returns a limiter allowing perSecond requests per second on
+// average with bursts of up to burst requests.
+func NewTokenBucket(perSecond float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &TokenBucket{
+		rate:   perSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+
+	// Take the token up front so concurrent callers queue behind each other.
+	b.tokens--
+	if b.tokens >= 0 {
+		b.mu.Unlock()
+		return nil
+	}
+
+	if b.rate <= 0 {
+		b.tokens++
+		b.mu.Unlock()
+		return fmt.Errorf("rate limiter does not allow any requests")
+	}
+
+	wait := time.Duration(-b.tokens / b.rate * float64(time.Second))
+	if deadline, ok := ctx.Deadline(); ok && now.Add(wait).After(deadline) {
+		b.tokens++
+		b.mu.Unlock()
+		return fmt.Errorf("rate limit wait of %s would exceed context deadline", wait)
+	}
+	b.mu.Unlock()
+
+	if err := sleep(ctx, wait); err != nil {
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+// Rate holds the rate limit information reported by the API in response
+// headers. Zero values mean the header was not present.
+type Rate struct {
+	// Number of requests allowed in the current window.
+	Limit int
+
+	// Number of requests left in the current window, -1 if the API did
+	// not report it.
+	Remaining int
+
+	// Time at which the current window resets.
+	Reset time.Time
+}
+
+// SetRateLimiter sets a limiter applied to every request of the client.
+// A nil limiter removes it.
+func (c *Client) SetRateLimiter(limiter RateLimiter) {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+
+	c.limiter = limiter
+}
+
+// SetEndpointRateLimiter sets a limiter for requests whose path starts with
+// prefix, e.g. "/apps/v2/vm" or "/api/v1/lb". When several prefixes match, the
+// longest wins. It is applied in addition to the client-wide limiter. A nil
+// limiter removes the entry.
+func (c *Client) SetEndpointRateLimiter(prefix string, limiter RateLimiter) {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+
+	if limiter == nil {
+		delete(c.endpointLimiters, prefix)
+		return
+	}
+
+	if c.endpointLimiters == nil {
+		c.endpointLimiters = make(map[string]RateLimiter)
+	}
+	c.endpointLimiters[prefix] = limiter
+}
+
+// Rate returns the rate limit state from the most recent response that
+// carried rate limit headers.
+func (c *Client) Rate() Rate {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+
+	return c.rate
+}
+
+// waitRateLimit blocks until req may be sent according to the configured
+// limiters and the last rate limit reported by the API.
+func (c *Client) waitRateLimit(ctx context.Context, req *http.Request) error {
+	c.rateMu.Lock()
+	global := c.limiter
+	var endpoint RateLimiter
+	matched := -1
+	for prefix, limiter := range c.endpointLimiters {
+		if strings.HasPrefix(req.URL.Path, prefix) && len(prefix) > matched {
+			endpoint, matched = limiter, len(prefix)
+		}
+	}
+	rate := c.rate
+	c.rateMu.Unlock()
+
+	if endpoint != nil {
+		if err := endpoint.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	if global != nil {
+		if err := global.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	// The API told us the budget is exhausted, hold off until it resets.
+	// Fail at once if it resets after the deadline of the caller.
+	if rate.Limit > 0 && rate.Remaining == 0 && !rate.Reset.IsZero() {
+		if wait := time.Until(rate.Reset); wait > 0 {
+			if deadline, ok := ctx.Deadline(); ok && rate.Reset.After(deadline) {
+				return fmt.Errorf("vpsie: rate limit resets in %s, after the deadline: %w", wait.Round(time.Second), ErrRateLimited)
+			}
+			return sleep(ctx, wait)
+		}
+	}
+
+	return nil
+}
+
+// updateRate records the rate limit headers of res, if any.
+func (c *Client) updateRate(res *http.Response) {
+	rate, ok := parseRate(res.Header, time.Now())
+	if !ok {
+		return
+	}
+
+	c.rateMu.Lock()
+	c.rate = rate
+	c.rateMu.Unlock()
+}
+
+// parseRate reads the X-RateLimit-* headers or their RateLimit-* counterparts.
+// Reset may be given either as a unix timestamp or as seconds from now.
+func parseRate(h http.Header, now time.Time) (Rate, bool) {
+	get := func(name string) string {
+		if v := h.Get("X-RateLimit-" + name); v != "" {
+			return v
+		}
+		return h.Get("RateLimit-" + name)
+	}
+
+	limit, limitErr := strconv.Atoi(get("Limit"))
+	remaining, remainingErr := strconv.Atoi(get("Remaining"))
+	if limitErr != nil && remainingErr != nil {
+		return Rate{}, false
+	}
+	if remainingErr != nil {
+		remaining = -1
+	}
+
+	rate := Rate{Limit: limit, Remaining: remaining}
+	if reset, err := strconv.ParseInt(get("Reset"), 10, 64); err == nil {
+		// Values that cannot be a recent unix timestamp are deltas.
+		if reset > 1e9 {
+			rate.Reset = time.Unix(reset, 0)
+		} else {
+			rate.Reset = now.Add(time.Duration(reset) * time.Second)
+		}
+	}
+
+	return rate, true
+}
@@ -0,0 +1,98 @@
+package govpsie
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketRespectsDeadline(t *testing.T) {
+	bucket := NewTokenBucket(1, 2)
+
+	for i := 0; i < 2; i++ {
+		if err := bucket.Wait(context.Background()); err != nil {
+			t.Fatalf("burst request %d: %v", i, err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := bucket.Wait(ctx); err == nil {
+		t.Fatal("expected wait beyond the deadline to fail")
+	}
+}
+
+func TestParseRate(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	h := http.Header{}
+	h.Set("X-RateLimit-Limit", "100")
+	h.Set("X-RateLimit-Remaining", "42")
+	h.Set("X-RateLimit-Reset", "30")
+
+	rate, ok := parseRate(h, now)
+	if !ok {
+		t.Fatal("expected rate headers to be parsed")
+	}
+	if rate.Limit != 100 || rate.Remaining != 42 || !rate.Reset.Equal(now.Add(30*time.Second)) {
+		t.Errorf("unexpected rate %+v", rate)
+	}
+
+	if _, ok := parseRate(http.Header{}, now); ok {
+		t.Error("expected no rate without headers")
+	}
+
+	h.Del("X-RateLimit-Remaining")
+	if rate, _ := parseRate(h, now); rate.Remaining != -1 {
+		t.Errorf("missing Remaining parsed as %d, want -1", rate.Remaining)
+	}
+}
+
+func TestWaitRateLimitIgnoresMissingRemaining(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Reset", "60")
+		_, _ = w.Write([]byte(`{"error":false}`))
+	})
+
+	ctx := context.Background()
+	for range 2 {
+		req, err := client.NewRequest(ctx, http.MethodGet, "/apps/v2/vm", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		start := time.Now()
+		if err := client.Do(ctx, req, nil); err != nil {
+			t.Fatal(err)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Fatalf("request waited %v for the rate limit reset", elapsed)
+		}
+	}
+}
+
+func TestWaitRateLimitFailsFastPastDeadline(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "60")
+		_, _ = w.Write([]byte(`{"error":false}`))
+	})
+
+	if _, err := client.Server.List(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	start := time.Now()
+	if _, err := client.Server.List(ctx, nil); !errors.Is(err, ErrRateLimited) {
+		t.Errorf("err = %v, want ErrRateLimited", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("waited %v", elapsed)
+	}
+}
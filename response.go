@@ -0,0 +1,119 @@
+package govpsie
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Response carries the metadata of an API response next to the decoded data.
+type Response struct {
+	// HTTP status code and headers of the response.
+	StatusCode int
+	Header     http.Header
+
+	// Total number of items in the collection, as reported by list
+	// endpoints. Zero when the response carries no count.
+	Total int
+
+	// Offset and Limit of the page that was requested, zero for unpaged
+	// requests.
+	Offset int
+	Limit  int
+
+	// NextPage is the page to request next, for use in ListOptions.Page.
+	// Zero when this is the last page or the total is unknown.
+	NextPage int
+
+	// Cursor is the opaque continuation token returned by cursor based
+	// endpoints, if any.
+	Cursor string
+
+	// RequestID identifies the request in the API logs, taken from the
+	// X-Request-Id header.
+	RequestID string
+
+	// Rate limit state reported with the response.
+	Rate Rate
+
+	// HTTPResponse is the underlying response. Its body has already been
+	// read and closed.
+	HTTPResponse *http.Response
+}
+
+// newResponse builds the Response metadata for res, whose body has already
+// been read into body.
+func newResponse(req *http.Request, res *http.Response, body []byte) *Response {
+	response := &Response{
+		StatusCode:   res.StatusCode,
+		Header:       res.Header,
+		RequestID:    res.Header.Get("X-Request-Id"),
+		HTTPResponse: res,
+	}
+	if response.RequestID == "" {
+		response.RequestID = res.Header.Get("X-Correlation-Id")
+	}
+	response.Rate, _ = parseRate(res.Header, time.Now())
+
+	q := req.URL.Query()
+	response.Offset, _ = strconv.Atoi(q.Get("offset"))
+	response.Limit, _ = strconv.Atoi(q.Get("limit"))
+
+	response.populatePageValues(body)
+
+	return response
+}
+
+// populatePageValues probes the list envelopes used by the API,
+// {"data":[...],"total":n} and {"data":{"rows":[...],"count":n}}, for the
+// collection size and continuation cursor.
+func (r *Response) populatePageValues(body []byte) {
+	var root struct {
+		Data   json.RawMessage `json:"data"`
+		Total  json.Number     `json:"total"`
+		Count  json.Number     `json:"count"`
+		Cursor string          `json:"cursor"`
+		Next   string          `json:"nextCursor"`
+	}
+	if err := json.Unmarshal(body, &root); err != nil {
+		return
+	}
+
+	r.Total = atoiNumber(root.Total, root.Count)
+	r.Cursor = root.Cursor
+	if r.Cursor == "" {
+		r.Cursor = root.Next
+	}
+
+	if len(root.Data) > 0 && root.Data[0] == '{' {
+		var data struct {
+			Total  json.Number `json:"total"`
+			Count  json.Number `json:"count"`
+			Cursor string      `json:"cursor"`
+		}
+		if err := json.Unmarshal(root.Data, &data); err == nil {
+			if r.Total == 0 {
+				r.Total = atoiNumber(data.Total, data.Count)
+			}
+			if r.Cursor == "" {
+				r.Cursor = data.Cursor
+			}
+		}
+	}
+
+	if r.Limit > 0 && r.Total > r.Offset+r.Limit {
+		r.NextPage = (r.Offset+r.Limit)/r.Limit + 1
+	}
+}
+
+// atoiNumber returns the first of numbers that holds a valid integer.
+func atoiNumber(numbers ...json.Number) int {
+	for _, n := range numbers {
+		if v, err := n.Int64(); err == nil && v > 0 {
+			return int(v)
+		}
+	}
+
+	return 0
+}
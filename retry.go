@@ -0,0 +1,193 @@
+package govpsie
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultMaxAttempts = 3
+	defaultMinBackoff  = 500 * time.Millisecond
+	defaultMaxBackoff  = 30 * time.Second
+	defaultJitter      = 0.2
+)
+
+// RetryPolicy controls how Client.Do retries requests that failed with a
+// transient error. Safe methods (GET, HEAD, OPTIONS) are retried by default;
+// other methods only when RetryNonIdempotent is set or the request context
+// was marked with WithRetry.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values below 2 disable retries.
+	MaxAttempts int
+
+	// MinBackoff and MaxBackoff bound the exponential backoff between attempts.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// Jitter randomizes each backoff by up to this fraction (0 to 1).
+	Jitter float64
+
+	// RetryNonIdempotent allows retrying POST, PUT, PATCH and DELETE requests.
+	RetryNonIdempotent bool
+
+	// CheckRetry decides whether a response or transport error is worth
+	// retrying. Defaults to DefaultCheckRetry.
+	CheckRetry func(res *http.Response, err error) bool
+
+	// Backoff returns the delay before the given attempt (starting at 1 for
+	// the first retry). Defaults to exponential backoff with jitter, capped
+	// at MaxBackoff, or to the Retry-After header when the response has one.
+	// A Retry-After beyond MaxBackoff ends the retries, the response being
+	// returned as is.
+	Backoff func(attempt int, res *http.Response) time.Duration
+}
+
+// DefaultRetryPolicy returns the policy used by NewClient.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: defaultMaxAttempts,
+		MinBackoff:  defaultMinBackoff,
+		MaxBackoff:  defaultMaxBackoff,
+		Jitter:      defaultJitter,
+	}
+}
+
+// DefaultCheckRetry retries transport errors, 429 and the 502/503/504 family
+// of gateway errors. Context cancellation is never retried, nor are transport
+// errors with a Retryable method that returns false.
+func DefaultCheckRetry(res *http.Response, err error) bool {
+	if err != nil {
+		var retryable interface{ Retryable() bool }
+		if errors.As(err, &retryable) && !retryable.Retryable() {
+			return false
+		}
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+type retryContextKey struct{}
+
+// WithRetry marks ctx so that requests made with it may be retried even if
+// their method is not idempotent.
+func WithRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryContextKey{}, true)
+}
+
+// SetRetryPolicy replaces the retry policy of the client. A nil policy
+// disables retries.
+func (c *Client) SetRetryPolicy(policy *RetryPolicy) {
+	c.retryPolicy = policy
+}
+
+// attempts returns how many times req may be sent.
+func (p *RetryPolicy) attempts(req *http.Request) int {
+	if p == nil || p.MaxAttempts < 2 {
+		return 1
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return p.MaxAttempts
+	}
+
+	if p.RetryNonIdempotent {
+		return p.MaxAttempts
+	}
+	if optIn, _ := req.Context().Value(retryContextKey{}).(bool); optIn {
+		return p.MaxAttempts
+	}
+
+	return 1
+}
+
+func (p *RetryPolicy) shouldRetry(res *http.Response, err error) bool {
+	if p.CheckRetry != nil {
+		return p.CheckRetry(res, err)
+	}
+
+	return DefaultCheckRetry(res, err)
+}
+
+// backoff returns the delay before attempt, or false if the response asks
+// for a longer wait than the policy allows.
+func (p *RetryPolicy) backoff(attempt int, res *http.Response) (time.Duration, bool) {
+	if p.Backoff != nil {
+		return p.Backoff(attempt, res), true
+	}
+
+	minBackoff, maxBackoff := p.MinBackoff, p.MaxBackoff
+	if minBackoff <= 0 {
+		minBackoff = defaultMinBackoff
+	}
+	if maxBackoff < minBackoff {
+		maxBackoff = minBackoff
+	}
+
+	// Retrying earlier than Retry-After is pointless, and a longer wait
+	// than MaxBackoff a sign the caller is better off failing.
+	if res != nil {
+		if wait, ok := parseRetryAfter(res.Header.Get("Retry-After"), time.Now()); ok {
+			return wait, wait <= maxBackoff
+		}
+	}
+
+	wait := float64(minBackoff) * math.Pow(2, float64(attempt-1))
+	if wait > float64(maxBackoff) {
+		wait = float64(maxBackoff)
+	}
+	if p.Jitter > 0 {
+		wait += wait * p.Jitter * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(wait), true
+}
+
+// parseRetryAfter understands both the delay-seconds and HTTP-date forms.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		wait := at.Sub(now)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,150 @@
+package govpsie
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDoRetriesSafeMethods(t *testing.T) {
+	var calls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"error":false,"data":[]}`))
+	})
+
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "/apps/v2/vm", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.Do(context.Background(), req, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("calls = %d, want 3", got)
+	}
+}
+
+func TestDoRetriesPostOnlyWhenOptedIn(t *testing.T) {
+	var calls atomic.Int32
+	var bodies []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"error":false}`))
+	})
+
+	payload := &ActionRequest{VmIdentifier: "vm-1"}
+
+	req, err := client.NewRequest(context.Background(), http.MethodPost, "/apps/v2/vm/start", payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Do(context.Background(), req, nil); err == nil {
+		t.Fatal("expected POST without opt-in to fail")
+	}
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("calls = %d, want 1", got)
+	}
+
+	calls.Store(0)
+	bodies = nil
+	ctx := WithRetry(context.Background())
+	req, err = client.NewRequest(ctx, http.MethodPost, "/apps/v2/vm/start", payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Do(ctx, req, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(bodies) != 2 || bodies[0] != bodies[1] || bodies[0] == "" {
+		t.Errorf("expected the same payload to be resent, got %q", bodies)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	if d, ok := parseRetryAfter("7", now); !ok || d != 7*time.Second {
+		t.Errorf("seconds form: got %v, %v", d, ok)
+	}
+	if d, ok := parseRetryAfter(now.Add(time.Minute).Format(http.TimeFormat), now); !ok || d != time.Minute {
+		t.Errorf("date form: got %v, %v", d, ok)
+	}
+	if _, ok := parseRetryAfter("soon", now); ok {
+		t.Error("expected invalid value to be rejected")
+	}
+}
+
+func TestBackoffHonorsRetryAfter(t *testing.T) {
+	policy := &RetryPolicy{MinBackoff: time.Millisecond, MaxBackoff: time.Minute}
+
+	res := &http.Response{Header: http.Header{"Retry-After": {"30"}}}
+	if got, ok := policy.backoff(1, res); got != 30*time.Second || !ok {
+		t.Errorf("backoff = %v, %v, want 30s, true", got, ok)
+	}
+
+	res.Header.Set("Retry-After", "3600")
+	if _, ok := policy.backoff(1, res); ok {
+		t.Error("Retry-After beyond MaxBackoff should end the retries")
+	}
+}
+
+func TestDoReturnsResponseWhenRetryAfterTooLong(t *testing.T) {
+	var calls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := client.Server.List(context.Background(), nil)
+	if !errors.Is(err, ErrServer) {
+		t.Errorf("err = %v, want the 503 error", err)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("calls = %d, want 1", got)
+	}
+}
+
+func TestDoDoesNotSleepPastDeadline(t *testing.T) {
+	var calls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	client.SetRetryPolicy(&RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Minute})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	req, err := client.NewRequest(ctx, http.MethodGet, "/apps/v2/vm", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	err = client.Do(ctx, req, nil)
+	if !errors.Is(err, ErrRateLimited) {
+		t.Errorf("err = %v, want the rate limit error", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("waited %v", elapsed)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("calls = %d, want 1", got)
+	}
+}
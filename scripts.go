@@ -11,6 +11,7 @@ var scriptsBasePath = "/apps/v2"
 
 type ScriptsService interface {
 	GetScripts(ctx context.Context) ([]Script, error)
+	ListScriptsWithResponse(ctx context.Context, options *ListOptions) ([]Script, *Response, error)
 	GetScript(ctx context.Context, scriptId string) (ScriptDetail, error)
 	CreateScript(ctx context.Context, createScriptRequest *CreateScriptRequest) error
 	UpdateScript(ctx context.Context, scriptUpdateRequest *ScriptUpdateRequest) error
@@ -32,6 +33,7 @@ type Script struct {
 	CreatedOn     time.Time `json:"created_on"`
 	Identifier    string    `json:"identifier"`
 	CreatedBy     string    `json:"created_by"`
+	Tags          TagList   `json:"tags,omitempty"`
 }
 
 type ScriptDetail struct {
@@ -73,6 +75,8 @@ type ScriptRoot struct {
 }
 
 func (s *scriptsServiceHandler) GetScripts(ctx context.Context) ([]Script, error) {
+	ctx = withOperation(ctx, "ScriptsService", "GetScripts")
+
 	path := fmt.Sprintf("%s/scripts", scriptsBasePath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -87,7 +91,31 @@ func (s *scriptsServiceHandler) GetScripts(ctx context.Context) ([]Script, error
 	return scripts.Data, nil
 }
 
+// ListScriptsWithResponse lists one page of scripts.
+func (s *scriptsServiceHandler) ListScriptsWithResponse(ctx context.Context, options *ListOptions) ([]Script, *Response, error) {
+	ctx = withOperation(ctx, "ScriptsService", "ListScripts")
+
+	path, err := addOptions(fmt.Sprintf("%s/scripts", scriptsBasePath), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	scripts := new(ListScriptRoot)
+	resp, err := s.client.DoWithResponse(ctx, req, scripts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return scripts.Data, resp, nil
+}
+
 func (s *scriptsServiceHandler) GetScript(ctx context.Context, scriptId string) (ScriptDetail, error) {
+	ctx = withOperation(ctx, "ScriptsService", "GetScript")
+
 	path := fmt.Sprintf("%s/script/%s", scriptsBasePath, scriptId)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -103,6 +131,8 @@ func (s *scriptsServiceHandler) GetScript(ctx context.Context, scriptId string)
 }
 
 func (s *scriptsServiceHandler) CreateScript(ctx context.Context, createScriptRequest *CreateScriptRequest) error {
+	ctx = withOperation(ctx, "ScriptsService", "CreateScript")
+
 	path := fmt.Sprintf("%s/script/add", scriptsBasePath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, createScriptRequest)
@@ -118,6 +148,8 @@ func (s *scriptsServiceHandler) CreateScript(ctx context.Context, createScriptRe
 }
 
 func (s *scriptsServiceHandler) UpdateScript(ctx context.Context, scriptUpdateRequest *ScriptUpdateRequest) error {
+	ctx = withOperation(ctx, "ScriptsService", "UpdateScript")
+
 	path := fmt.Sprintf("%s/script/edit", scriptsBasePath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPut, path, scriptUpdateRequest)
@@ -133,6 +165,8 @@ func (s *scriptsServiceHandler) UpdateScript(ctx context.Context, scriptUpdateRe
 }
 
 func (s *scriptsServiceHandler) DeleteScript(ctx context.Context, scriptId string) error {
+	ctx = withOperation(ctx, "ScriptsService", "DeleteScript")
+
 	path := fmt.Sprintf("%s/script", scriptsBasePath)
 
 	deltReq := struct {
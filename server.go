@@ -11,11 +11,14 @@ var serverBasePath = "/api/v2/vm"
 
 type ServerService interface {
 	ListServer(context.Context, *ListOptions, string) ([]VmData, error)
+	ListServerWithResponse(context.Context, *ListOptions, string) ([]VmData, *Response, error)
 	List(context.Context, *ListOptions) ([]VmData, error)
+	ListWithResponse(context.Context, *ListOptions) ([]VmData, *Response, error)
 	GetServerByIdentifier(context.Context, string) (*VmData, error)
+	GetServerDetails(ctx context.Context, identifierId string) (*ServerDetails, error)
 	GetServerStatusByIdentifier(context.Context, string) (*Status, error)
 	GetServerConsole(ctx context.Context, identifierId string) (*ServerConsole, error)
-	CreateServer(context.Context, *CreateServerRequest) error
+	CreateServer(context.Context, *CreateServerRequest) (*ServerCreation, error)
 	DeleteServer(ctx context.Context, identifierId, password, reason, note string) error
 	StartServer(ctx context.Context, identifierId string) error
 	StopServer(ctx context.Context, identifierId string) error
@@ -42,6 +45,14 @@ type ServerService interface {
 	ListVirtualMachines(ctx context.Context) ([]VirtualMachine, error)
 	ListAllNodesOfUser(ctx context.Context) ([]VmData, error)
 	CheckAgentStatus(ctx context.Context, vmIdentifier string) (bool, error)
+	ListResourcePlans(ctx context.Context, dcIdentifier string) ([]ResourcePlan, error)
+	WaitForServerState(ctx context.Context, identifierId string, state ServerState, opts *WaitOptions) (*VmData, error)
+	CreateServerAndWait(ctx context.Context, server *CreateServerRequest, opts *WaitOptions) (*VmData, error)
+	StartServerAndWait(ctx context.Context, identifierId string, opts *WaitOptions) (*VmData, error)
+	StopServerAndWait(ctx context.Context, identifierId string, opts *WaitOptions) (*VmData, error)
+	RestartServerAndWait(ctx context.Context, identifierId string, opts *WaitOptions) (*VmData, error)
+	ResizeServerAndWait(ctx context.Context, identifierId, cpu, ram string, opts *WaitOptions) (*VmData, error)
+	ResumeAndWait(ctx context.Context, resumeReq *ResumeReq, opts *WaitOptions) (*VmData, error)
 }
 
 type serverServiceHandler struct {
@@ -56,26 +67,75 @@ type ListServerRoot struct {
 	Total int64    `json:"total"`
 }
 
+// ListServerByIdentifierRoot leaves out the details of the server, so that
+// GetServerByIdentifier does not depend on their shape.
 type ListServerByIdentifierRoot struct {
 	Error bool `json:"error"`
 	Data  struct {
-		VmData          VmData            `json:"vmData"`
-		ImageCategories []ImageCategories `json:"imageCategories"`
-		VmTags          []VmTags          `json:"vmTags"`
-		PrivateIpData   []PrivateIpData   `json:"privateIpData"`
-		FloatingIpData  []FloatingIpData  `json:"floatingIpData"`
+		VmData VmData `json:"vmData"`
 	} `json:"data"`
 	Total int64 `json:"total"`
 }
 
+type serverDetailsRoot struct {
+	Error bool          `json:"error"`
+	Data  ServerDetails `json:"data"`
+}
+
+// ServerDetails is the full view of a server returned by GetServerDetails.
+type ServerDetails struct {
+	VmData          VmData            `json:"vmData"`
+	ImageCategories []ImageCategories `json:"imageCategories"`
+	VmTags          []VmTags          `json:"vmTags"`
+	PrivateIpData   []PrivateIpData   `json:"privateIpData"`
+	FloatingIpData  []FloatingIpData  `json:"floatingIpData"`
+}
+
+// TagNames returns the names of the server tags.
+func (d *ServerDetails) TagNames() []string {
+	if len(d.VmTags) == 0 {
+		return d.VmData.Tags
+	}
+
+	names := make([]string, len(d.VmTags))
+	for i, tag := range d.VmTags {
+		names[i] = tag.Name
+	}
+
+	return names
+}
+
 type ImageCategories struct {
+	ID         int64  `json:"id"`
+	Identifier string `json:"identifier"`
+	Name       string `json:"name"`
+	Category   string `json:"category"`
 }
+
 type VmTags struct {
+	ID         int64  `json:"id"`
+	Identifier string `json:"identifier"`
+	Name       string `json:"name"`
+	CreatedOn  string `json:"created_on"`
 }
+
 type PrivateIpData struct {
+	ID        int64  `json:"id"`
+	IP        string `json:"ip"`
+	IPVersion string `json:"ip_version"`
+	IsPrimary int64  `json:"is_primary"`
+	VpcID     int64  `json:"vpc_id"`
+	VpcName   string `json:"vpcName"`
 }
+
 type FloatingIpData struct {
+	ID           int64  `json:"id"`
+	IP           string `json:"ip"`
+	IPVersion    string `json:"ip_version"`
+	DcIdentifier string `json:"dcIdentifier"`
+	CreatedOn    string `json:"created_on"`
 }
+
 type VmData struct {
 	ID                  int64   `json:"id"`
 	UserID              int64   `json:"user_id"`
@@ -146,6 +206,7 @@ type VmData struct {
 	IsSsdAvailable      int64   `json:"is_ssd_available"`
 	PublicIp            *string `json:"publicIp,omitempty"`
 	VMType              string  `json:"vmType,omitempty"`
+	Tags                TagList `json:"tags,omitempty"`
 }
 
 type Status struct {
@@ -291,35 +352,61 @@ type AgentStatus struct {
 }
 
 func (v *serverServiceHandler) ListServer(ctx context.Context, options *ListOptions, projectId string) ([]VmData, error) {
-	path := fmt.Sprintf("%s?projectId=%s", serverBasePath, projectId)
+	items, _, err := v.ListServerWithResponse(ctx, options, projectId)
+	return items, err
+}
+
+func (v *serverServiceHandler) ListServerWithResponse(ctx context.Context, options *ListOptions, projectId string) ([]VmData, *Response, error) {
+	ctx = withOperation(ctx, "ServerService", "ListServer")
+
+	path, err := addOptions(fmt.Sprintf("%s?projectId=%s", serverBasePath, projectId), options)
+	if err != nil {
+		return nil, nil, err
+	}
 	req, err := v.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	servers := new(ListServerRoot)
-	if err = v.client.Do(ctx, req, servers); err != nil {
-		return nil, err
+	resp, err := v.client.DoWithResponse(ctx, req, servers)
+	if err != nil {
+		return nil, resp, err
 	}
 
-	return servers.Data, nil
+	return servers.Data, resp, nil
 }
 
 func (v *serverServiceHandler) List(ctx context.Context, options *ListOptions) ([]VmData, error) {
-	req, err := v.client.NewRequest(ctx, http.MethodGet, serverBasePath, nil)
+	items, _, err := v.ListWithResponse(ctx, options)
+	return items, err
+}
+
+func (v *serverServiceHandler) ListWithResponse(ctx context.Context, options *ListOptions) ([]VmData, *Response, error) {
+	ctx = withOperation(ctx, "ServerService", "List")
+
+	path, err := addOptions(serverBasePath, options)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	req, err := v.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	Servers := new(ListServerRoot)
-	if err = v.client.Do(ctx, req, Servers); err != nil {
-		return nil, err
+	resp, err := v.client.DoWithResponse(ctx, req, Servers)
+	if err != nil {
+		return nil, resp, err
 	}
 
-	return Servers.Data, nil
+	return Servers.Data, resp, nil
 }
 
 func (v *serverServiceHandler) GetServerByIdentifier(ctx context.Context, identifierId string) (*VmData, error) {
+	ctx = withOperation(ctx, "ServerService", "GetServerByIdentifier")
+
 	path := fmt.Sprintf("%s/%s", serverBasePath, identifierId)
 	req, err := v.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -334,8 +421,29 @@ func (v *serverServiceHandler) GetServerByIdentifier(ctx context.Context, identi
 	return &Servers.Data.VmData, nil
 }
 
+// GetServerDetails returns a server together with its tags, image category
+// and attached private and floating IPs.
+func (v *serverServiceHandler) GetServerDetails(ctx context.Context, identifierId string) (*ServerDetails, error) {
+	ctx = withOperation(ctx, "ServerService", "GetServerDetails")
+
+	path := fmt.Sprintf("%s/%s", serverBasePath, identifierId)
+	req, err := v.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	server := new(serverDetailsRoot)
+	if err = v.client.Do(ctx, req, server); err != nil {
+		return nil, err
+	}
+
+	return &server.Data, nil
+}
+
 func (v *serverServiceHandler) GetServerStatusByIdentifier(ctx context.Context, identifierId string) (*Status, error) {
-	path := fmt.Sprintf("%s/status/ %s", serverBasePath, identifierId)
+	ctx = withOperation(ctx, "ServerService", "GetServerStatusByIdentifier")
+
+	path := fmt.Sprintf("%s/status/%s", serverBasePath, identifierId)
 	req, err := v.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -350,6 +458,8 @@ func (v *serverServiceHandler) GetServerStatusByIdentifier(ctx context.Context,
 }
 
 func (v *serverServiceHandler) GetServerConsole(ctx context.Context, identifierId string) (*ServerConsole, error) {
+	ctx = withOperation(ctx, "ServerService", "GetServerConsole")
+
 	path := fmt.Sprintf("%s/console/%s", serverBasePath, identifierId)
 	req, err := v.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -364,20 +474,38 @@ func (v *serverServiceHandler) GetServerConsole(ctx context.Context, identifierI
 	return &console.ServerConsole, nil
 }
 
-func (v *serverServiceHandler) CreateServer(ctx context.Context, server *CreateServerRequest) error {
-	req, err := v.client.NewRequest(ctx, http.MethodPost, serverBasePath, server)
+// CreateServer requests a new server. The returned ServerCreation carries its
+// identifier when the API reports it and can wait for the provisioning. An
+// empty ProcessID is generated to track the server in the pending queue.
+func (v *serverServiceHandler) CreateServer(ctx context.Context, server *CreateServerRequest) (*ServerCreation, error) {
+	ctx = withOperation(ctx, "ServerService", "CreateServer")
+
+	body := *server
+	if body.ProcessID == "" {
+		body.ProcessID = newProcessID()
+	}
+
+	req, err := v.client.NewRequest(ctx, http.MethodPost, serverBasePath, &body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if err = v.client.Do(ctx, req, nil); err != nil {
-		return err
+	created := new(CreateServerRoot)
+	if err = v.client.Do(ctx, req, created); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return &ServerCreation{
+		Identifier:   created.Data.Identifier,
+		ProcessID:    body.ProcessID,
+		Hostname:     body.Hostname,
+		DcIdentifier: body.DcIdentifier,
+		client:       v.client,
+	}, nil
 }
 
 func (v *serverServiceHandler) DeleteServer(ctx context.Context, identifierId, password, reason, note string) error {
+	ctx = withOperation(ctx, "ServerService", "DeleteServer")
 
 	deleteReq := struct {
 		VMIdentifier    string `json:"vmIdentifier"`
@@ -411,6 +539,8 @@ func (v *serverServiceHandler) DeleteServer(ctx context.Context, identifierId, p
 }
 
 func (v *serverServiceHandler) StartServer(ctx context.Context, identifierId string) error {
+	ctx = withOperation(ctx, "ServerService", "StartServer")
+
 	vmIdentifier := &ActionRequest{
 		VmIdentifier: identifierId,
 	}
@@ -429,6 +559,8 @@ func (v *serverServiceHandler) StartServer(ctx context.Context, identifierId str
 }
 
 func (v *serverServiceHandler) StopServer(ctx context.Context, identifierId string) error {
+	ctx = withOperation(ctx, "ServerService", "StopServer")
+
 	vmIdentifier := &ActionRequest{
 		VmIdentifier: identifierId,
 	}
@@ -447,6 +579,8 @@ func (v *serverServiceHandler) StopServer(ctx context.Context, identifierId stri
 }
 
 func (v *serverServiceHandler) RestartServer(ctx context.Context, identifierId string) error {
+	ctx = withOperation(ctx, "ServerService", "RestartServer")
+
 	vmIdentifier := &ActionRequest{
 		VmIdentifier: identifierId,
 	}
@@ -464,6 +598,8 @@ func (v *serverServiceHandler) RestartServer(ctx context.Context, identifierId s
 }
 
 func (v *serverServiceHandler) ChangePassword(ctx context.Context, identifierId string, newPassword string) error {
+	ctx = withOperation(ctx, "ServerService", "ChangePassword")
+
 	changePassReq := struct {
 		VmIdentifier string `json:"vmIdentifier"`
 		NewPassword  string `json:"newpassword"`
@@ -485,6 +621,8 @@ func (v *serverServiceHandler) ChangePassword(ctx context.Context, identifierId
 }
 
 func (v *serverServiceHandler) ChangeHostName(ctx context.Context, identifierId string, newHostname string) error {
+	ctx = withOperation(ctx, "ServerService", "ChangeHostName")
+
 	changeHostNameReq := struct {
 		VmIdentifier string `json:"vmIdentifier"`
 		Hostname     string `json:"hostname"`
@@ -506,6 +644,8 @@ func (v *serverServiceHandler) ChangeHostName(ctx context.Context, identifierId
 }
 
 func (v *serverServiceHandler) AddVPC(ctx context.Context, request *VpcRequest) error {
+	ctx = withOperation(ctx, "ServerService", "AddVPC")
+
 	path := fmt.Sprintf("%s/add/vpc", serverBasePath)
 
 	req, err := v.client.NewRequest(ctx, http.MethodPost, path, request)
@@ -521,6 +661,8 @@ func (v *serverServiceHandler) AddVPC(ctx context.Context, request *VpcRequest)
 }
 
 func (v *serverServiceHandler) MoveVPC(ctx context.Context, request *VpcRequest) error {
+	ctx = withOperation(ctx, "ServerService", "MoveVPC")
+
 	path := fmt.Sprintf("%s/vpc/move", serverBasePath)
 
 	req, err := v.client.NewRequest(ctx, http.MethodPost, path, request)
@@ -536,6 +678,8 @@ func (v *serverServiceHandler) MoveVPC(ctx context.Context, request *VpcRequest)
 }
 
 func (v *serverServiceHandler) ResizeServer(ctx context.Context, identifierId, cpu, ram string) error {
+	ctx = withOperation(ctx, "ServerService", "ResizeServer")
+
 	path := fmt.Sprintf("%s/resize", serverBasePath)
 
 	resizeServer := struct {
@@ -562,6 +706,8 @@ func (v *serverServiceHandler) ResizeServer(ctx context.Context, identifierId, c
 
 // ResizeDisk resizes only the disk (SSD) of a VM. The VM must be stopped.
 func (v *serverServiceHandler) ResizeDisk(ctx context.Context, identifierId string, ssd int) error {
+	ctx = withOperation(ctx, "ServerService", "ResizeDisk")
+
 	path := fmt.Sprintf("%s/resize", serverBasePath)
 
 	resizeReq := struct {
@@ -585,6 +731,8 @@ func (v *serverServiceHandler) ResizeDisk(ctx context.Context, identifierId stri
 }
 
 func (v *serverServiceHandler) AddTags(ctx context.Context, identifierId string, tags []string) error {
+	ctx = withOperation(ctx, "ServerService", "AddTags")
+
 	path := fmt.Sprintf("%s/addtags", serverBasePath)
 
 	addTagsRequest := struct {
@@ -608,6 +756,8 @@ func (v *serverServiceHandler) AddTags(ctx context.Context, identifierId string,
 }
 
 func (v *serverServiceHandler) AddSsh(ctx context.Context, identifierId, sshKeyIdentifier string) error {
+	ctx = withOperation(ctx, "ServerService", "AddSsh")
+
 	path := fmt.Sprintf("%s/sshkey", serverBasePath)
 	addSshReq := struct {
 		VmIdentifier     string `json:"vmIdentifier"`
@@ -630,6 +780,8 @@ func (v *serverServiceHandler) AddSsh(ctx context.Context, identifierId, sshKeyI
 }
 
 func (v *serverServiceHandler) AddScript(ctx context.Context, identifierId, scriptIdentifier string) error {
+	ctx = withOperation(ctx, "ServerService", "AddScript")
+
 	path := fmt.Sprintf("%s/script", serverBasePath)
 	addScriptReq := struct {
 		VmIdentifier     string `json:"vmIdentifier"`
@@ -652,6 +804,8 @@ func (v *serverServiceHandler) AddScript(ctx context.Context, identifierId, scri
 }
 
 func (v *serverServiceHandler) Lock(ctx context.Context, identifierId string) error {
+	ctx = withOperation(ctx, "ServerService", "Lock")
+
 	path := fmt.Sprintf("%s/lock", serverBasePath)
 	lockReq := struct {
 		VmIdentifier string `json:"vmIdentifier"`
@@ -672,6 +826,8 @@ func (v *serverServiceHandler) Lock(ctx context.Context, identifierId string) er
 }
 
 func (v *serverServiceHandler) UnLock(ctx context.Context, identifierId string) error {
+	ctx = withOperation(ctx, "ServerService", "UnLock")
+
 	path := fmt.Sprintf("%s/unlock", serverBasePath)
 	unLockReq := struct {
 		VmIdentifier string `json:"vmIdentifier"`
@@ -692,6 +848,8 @@ func (v *serverServiceHandler) UnLock(ctx context.Context, identifierId string)
 }
 
 func (v *serverServiceHandler) DoMultiActions(ctx context.Context, vmsIdentifiers []string, actionType, sshKeyIdentifier string) error {
+	ctx = withOperation(ctx, "ServerService", "DoMultiActions")
+
 	path := fmt.Sprintf("%s/actions", serverBasePath)
 	doMultiActionsReq := struct {
 		VmsIdentifiers   []string `json:"vmsIdentifiers"`
@@ -716,6 +874,8 @@ func (v *serverServiceHandler) DoMultiActions(ctx context.Context, vmsIdentifier
 }
 
 func (v *serverServiceHandler) AddFip(ctx context.Context, identifierId, dcIdentifier string) error {
+	ctx = withOperation(ctx, "ServerService", "AddFip")
+
 	path := fmt.Sprintf("%s/fip/add", serverBasePath)
 	addFipReq := struct {
 		VmIdentifier string `json:"vmIdentifier"`
@@ -738,6 +898,8 @@ func (v *serverServiceHandler) AddFip(ctx context.Context, identifierId, dcIdent
 }
 
 func (v *serverServiceHandler) EnableIpv6(ctx context.Context, identifierId string) error {
+	ctx = withOperation(ctx, "ServerService", "EnableIpv6")
+
 	path := fmt.Sprintf("%s/enable/ipv6", serverBasePath)
 	enableIpv6Req := struct {
 		VmIdentifier string `json:"vmIdentifier"`
@@ -758,6 +920,8 @@ func (v *serverServiceHandler) EnableIpv6(ctx context.Context, identifierId stri
 }
 
 func (v *serverServiceHandler) EnableIpv4(ctx context.Context, identifierId string) error {
+	ctx = withOperation(ctx, "ServerService", "EnableIpv4")
+
 	path := fmt.Sprintf("%s/enable/ipv4", serverBasePath)
 	enableIpv4Req := struct {
 		VmIdentifier string `json:"vmIdentifier"`
@@ -778,6 +942,8 @@ func (v *serverServiceHandler) EnableIpv4(ctx context.Context, identifierId stri
 }
 
 func (v *serverServiceHandler) Resume(ctx context.Context, resumeReq *ResumeReq) error {
+	ctx = withOperation(ctx, "ServerService", "Resume")
+
 	path := fmt.Sprintf("%s/resume", serverBasePath)
 
 	req, err := v.client.NewRequest(ctx, http.MethodPost, path, resumeReq)
@@ -793,6 +959,8 @@ func (v *serverServiceHandler) Resume(ctx context.Context, resumeReq *ResumeReq)
 }
 
 func (v *serverServiceHandler) ResetNetwork(ctx context.Context, vmIdentifier string) error {
+	ctx = withOperation(ctx, "ServerService", "ResetNetwork")
+
 	path := fmt.Sprintf("/apps/v2/refresh/vm/ips/%s", vmIdentifier)
 
 	req, err := v.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -808,9 +976,20 @@ func (v *serverServiceHandler) ResetNetwork(ctx context.Context, vmIdentifier st
 }
 
 func (v *serverServiceHandler) EditTag(ctx context.Context, tags []string, vmIdentifer string) error {
+	ctx = withOperation(ctx, "ServerService", "EditTag")
+
 	path := fmt.Sprintf("%s/tags/edit", serverBasePath)
 
-	req, err := v.client.NewRequest(ctx, http.MethodPost, path, nil)
+	// Same body as AddTags, which the endpoint replaces rather than extends.
+	editTagReq := struct {
+		VmIdentifier string   `json:"vmIdentifier"`
+		Tags         []string `json:"tags"`
+	}{
+		VmIdentifier: vmIdentifer,
+		Tags:         tags,
+	}
+
+	req, err := v.client.NewRequest(ctx, http.MethodPost, path, editTagReq)
 	if err != nil {
 		return err
 	}
@@ -823,6 +1002,8 @@ func (v *serverServiceHandler) EditTag(ctx context.Context, tags []string, vmIde
 }
 
 func (v *serverServiceHandler) ListVirtualMachines(ctx context.Context) ([]VirtualMachine, error) {
+	ctx = withOperation(ctx, "ServerService", "ListVirtualMachines")
+
 	path := "/apps/v2/virtualMachines"
 	req, err := v.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -839,6 +1020,8 @@ func (v *serverServiceHandler) ListVirtualMachines(ctx context.Context) ([]Virtu
 }
 
 func (v *serverServiceHandler) ListAllNodesOfUser(ctx context.Context) ([]VmData, error) {
+	ctx = withOperation(ctx, "ServerService", "ListAllNodesOfUser")
+
 	path := "/apps/v2/vms/all/user"
 	req, err := v.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -855,6 +1038,8 @@ func (v *serverServiceHandler) ListAllNodesOfUser(ctx context.Context) ([]VmData
 }
 
 func (v *serverServiceHandler) CheckAgentStatus(ctx context.Context, vmIdentifier string) (bool, error) {
+	ctx = withOperation(ctx, "ServerService", "CheckAgentStatus")
+
 	path := fmt.Sprintf("%s/live/agent/status/%s", serverBasePath, vmIdentifier)
 	req, err := v.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -871,6 +1056,8 @@ func (v *serverServiceHandler) CheckAgentStatus(ctx context.Context, vmIdentifie
 }
 
 func (v *serverServiceHandler) ResetAllFirewalls(ctx context.Context) error {
+	ctx = withOperation(ctx, "ServerService", "ResetAllFirewalls")
+
 	path := fmt.Sprintf("%s/reset/firewall/for/all", serverBasePath)
 	req, err := v.client.NewRequest(ctx, http.MethodPost, path, nil)
 	if err != nil {
@@ -880,3 +1067,20 @@ func (v *serverServiceHandler) ResetAllFirewalls(ctx context.Context) error {
 	return v.client.Do(ctx, req, nil)
 }
 
+// ListResourcePlans lists the plans available in a data center.
+func (v *serverServiceHandler) ListResourcePlans(ctx context.Context, dcIdentifier string) ([]ResourcePlan, error) {
+	ctx = withOperation(ctx, "ServerService", "ListResourcePlans")
+
+	path := fmt.Sprintf("%s/resources/%s", serverBasePath, dcIdentifier)
+	req, err := v.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	plans := new(ListResourcePlanRoot)
+	if err = v.client.Do(ctx, req, plans); err != nil {
+		return nil, err
+	}
+
+	return plans.Data, nil
+}
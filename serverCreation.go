@@ -0,0 +1,174 @@
+package govpsie
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type CreateServerRoot struct {
+	Error bool `json:"error"`
+	Data  struct {
+		Identifier string `json:"identifier"`
+	} `json:"data"`
+}
+
+// ServerCreation is a server being provisioned, as returned by
+// ServerService.CreateServer.
+type ServerCreation struct {
+	// Identifier of the new server, empty if the API did not report it.
+	Identifier string
+
+	// ProcessID correlates the request with the pending queue.
+	ProcessID string
+
+	Hostname     string
+	DcIdentifier string
+
+	// known servers are not resolved as the new one, see Wait.
+	known map[string]bool
+
+	// seenPending is set once ProcessID was found in the pending queue.
+	seenPending bool
+
+	client *Client
+}
+
+// resolvePolls is the number of polls during which a server that was never
+// seen pending is not resolved to one listed on the first poll.
+const resolvePolls = 3
+
+// Wait blocks until the server has left the pending queue and is running,
+// then returns it. Without an identifier, the server is found by hostname
+// and data center as soon as it is not pending. The servers listed on the
+// first poll are skipped, unless the server was never seen pending and
+// resolvePolls polls have passed: it may have been listed already.
+func (s *ServerCreation) Wait(ctx context.Context, opts *WaitOptions) (*VmData, error) {
+	if s.client == nil {
+		return nil, errors.New("vpsie: ServerCreation was not returned by ServerService.CreateServer")
+	}
+
+	o := opts.withDefaults()
+
+	ctx, cancel := context.WithTimeout(ctx, o.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	interval := o.MinInterval
+
+	// Servers listed before the request are never the new one.
+	listedBefore := s.known != nil
+
+	for attempt := 1; ; attempt++ {
+		var err error
+		if s.known == nil && s.Identifier == "" {
+			s.known, err = s.client.serverIdentifiers(ctx, s.Hostname)
+		}
+
+		var pending bool
+		if err == nil {
+			pending, err = s.pending(ctx)
+		}
+		if err == nil && !pending && s.Identifier == "" {
+			err = s.resolve(ctx, listedBefore || s.seenPending || attempt <= resolvePolls)
+		}
+
+		if o.Progress != nil {
+			var state ServerState
+			if err == nil && pending {
+				state = ServerPending
+			}
+			o.Progress(WaitProgress{
+				Identifier: s.Identifier,
+				Attempt:    attempt,
+				Elapsed:    time.Since(start),
+				State:      state,
+				Err:        err,
+			})
+		}
+
+		if err != nil && ctx.Err() == nil {
+			return nil, err
+		}
+		if err == nil && !pending && s.Identifier != "" {
+			break
+		}
+
+		if err := sleep(ctx, interval); err != nil {
+			return nil, fmt.Errorf("vpsie: server %s still pending after %s: %w",
+				s.Hostname, time.Since(start).Round(time.Second), err)
+		}
+		interval = min(time.Duration(float64(interval)*o.Multiplier), o.MaxInterval)
+	}
+
+	// ctx carries the deadline of the whole wait.
+	return s.client.Server.WaitForServerState(ctx, s.Identifier, ServerRunning, &o)
+}
+
+// pending reports whether the server is still in the pending queue.
+func (s *ServerCreation) pending(ctx context.Context) (bool, error) {
+	vms, err := s.client.Pending.GetPendingVms(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, vm := range vms {
+		if s.ProcessID != "" && vm.Data.ProcessID == s.ProcessID {
+			s.seenPending = true
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// resolve finds the identifier of a server no longer pending, picking the
+// most recent server with the requested hostname and data center. The known
+// servers are skipped if skipKnown is set.
+func (s *ServerCreation) resolve(ctx context.Context, skipKnown bool) error {
+	var found *VmData
+	for vm, err := range s.client.AllServers(ctx, nil) {
+		if err != nil {
+			return err
+		}
+		if vm.Hostname != s.Hostname || (s.DcIdentifier != "" && vm.DcIdentifier != s.DcIdentifier) || skipKnown && s.known[vm.Identifier] {
+			continue
+		}
+		if found == nil || vm.ID > found.ID {
+			found = &vm
+		}
+	}
+
+	if found != nil {
+		s.Identifier = found.Identifier
+	}
+
+	return nil
+}
+
+// serverIdentifiers returns the identifiers of the existing servers named
+// hostname, or of all servers if hostname is empty.
+func (c *Client) serverIdentifiers(ctx context.Context, hostname string) (map[string]bool, error) {
+	identifiers := make(map[string]bool)
+	for vm, err := range c.AllServers(ctx, nil) {
+		if err != nil {
+			return nil, err
+		}
+		if hostname == "" || vm.Hostname == hostname {
+			identifiers[vm.Identifier] = true
+		}
+	}
+
+	return identifiers, nil
+}
+
+// newProcessID returns a random identifier for CreateServerRequest.ProcessID.
+func newProcessID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,187 @@
+package govpsie
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestServerCreationWaitsForPendingQueue(t *testing.T) {
+	var (
+		processID    atomic.Value
+		pendingPolls atomic.Int32
+	)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/vm":
+			var req CreateServerRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Error(err)
+			}
+			processID.Store(req.ProcessID)
+			fmt.Fprint(w, `{"error":false}`)
+		case r.URL.Path == "/apps/v2/vm/pending":
+			if pendingPolls.Add(1) > 2 {
+				fmt.Fprint(w, `{"error":false,"data":[]}`)
+				return
+			}
+			fmt.Fprintf(w, `{"error":false,"data":[{"data":{"hostname":"web-1","processId":%q}}]}`, processID.Load())
+		case r.URL.Path == "/api/v2/vm" && pendingPolls.Load() < 3:
+			fmt.Fprint(w, `{"error":false,"total":1,"data":[{"id":1,"identifier":"old","hostname":"web-1","dcIdentifier":"dc-1"}]}`)
+		case r.URL.Path == "/api/v2/vm":
+			fmt.Fprint(w, `{"error":false,"total":3,"data":[
+				{"id":1,"identifier":"old","hostname":"web-1","dcIdentifier":"dc-1"},
+				{"id":3,"identifier":"new","hostname":"web-1","dcIdentifier":"dc-1"},
+				{"id":4,"identifier":"other","hostname":"web-1","dcIdentifier":"dc-2"}]}`)
+		case r.URL.Path == "/api/v2/vm/new":
+			fmt.Fprint(w, `{"error":false,"data":{"vmData":{"id":3,"identifier":"new","state":"running"}}}`)
+		case r.URL.Path == "/api/v2/vm/status/new":
+			fmt.Fprint(w, `{"error":false,"data":{"status":"running"}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+	creation, err := client.Server.CreateServer(ctx, &CreateServerRequest{Hostname: "web-1", DcIdentifier: "dc-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creation.Identifier != "" || creation.ProcessID == "" || creation.ProcessID != processID.Load() {
+		t.Fatalf("creation = %+v, sent processId %v", creation, processID.Load())
+	}
+
+	vm, err := creation.Wait(ctx, fastWait)
+	if err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	if vm.Identifier != "new" || creation.Identifier != "new" {
+		t.Errorf("resolved %q, want new", vm.Identifier)
+	}
+	if got := pendingPolls.Load(); got != 3 {
+		t.Errorf("polled the pending queue %d times, want 3", got)
+	}
+}
+
+func TestServerCreationSkipsExistingServerWithSameHostname(t *testing.T) {
+	var (
+		processID    atomic.Value
+		pendingPolls atomic.Int32
+	)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/vm":
+			var req CreateServerRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Error(err)
+			}
+			processID.Store(req.ProcessID)
+			fmt.Fprint(w, `{"error":false}`)
+		case r.URL.Path == "/apps/v2/vm/pending":
+			// The queue lags behind the request, then empties.
+			switch pendingPolls.Add(1) {
+			case 1:
+				fmt.Fprint(w, `{"error":false,"data":[]}`)
+			case 2:
+				fmt.Fprintf(w, `{"error":false,"data":[{"data":{"hostname":"web-1","processId":%q}}]}`, processID.Load())
+			default:
+				fmt.Fprint(w, `{"error":false,"data":[]}`)
+			}
+		case r.URL.Path == "/api/v2/vm" && pendingPolls.Load() < 3:
+			// The existing server has a higher id than the new one will.
+			fmt.Fprint(w, `{"error":false,"total":1,"data":[{"id":9,"identifier":"existing","hostname":"web-1","dcIdentifier":"dc-1"}]}`)
+		case r.URL.Path == "/api/v2/vm":
+			fmt.Fprint(w, `{"error":false,"total":2,"data":[
+				{"id":9,"identifier":"existing","hostname":"web-1","dcIdentifier":"dc-1"},
+				{"id":3,"identifier":"new","hostname":"web-1","dcIdentifier":"dc-1"}]}`)
+		case r.URL.Path == "/api/v2/vm/new":
+			fmt.Fprint(w, `{"error":false,"data":{"vmData":{"id":3,"identifier":"new","state":"running"}}}`)
+		case r.URL.Path == "/api/v2/vm/status/new":
+			fmt.Fprint(w, `{"error":false,"data":{"status":"running"}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+	creation, err := client.Server.CreateServer(ctx, &CreateServerRequest{Hostname: "web-1", DcIdentifier: "dc-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var states []ServerState
+	wait := *fastWait
+	wait.Progress = func(p WaitProgress) {
+		if p.Server == nil {
+			states = append(states, p.State)
+		}
+	}
+	vm, err := creation.Wait(ctx, &wait)
+	if err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	if vm.Identifier != "new" {
+		t.Errorf("resolved %q, want new", vm.Identifier)
+	}
+	if want := []ServerState{"", ServerPending, ""}; fmt.Sprint(states) != fmt.Sprint(want) {
+		t.Errorf("progress states = %q, want %q", states, want)
+	}
+}
+
+func TestServerCreationResolvesServerNeverSeenPending(t *testing.T) {
+	var listed, pendingPolls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/vm":
+			fmt.Fprint(w, `{"error":false}`)
+		case r.URL.Path == "/apps/v2/vm/pending":
+			// The server left the queue before the first poll.
+			pendingPolls.Add(1)
+			fmt.Fprint(w, `{"error":false,"data":[]}`)
+		case r.URL.Path == "/api/v2/vm":
+			listed.Add(1)
+			fmt.Fprint(w, `{"error":false,"total":2,"data":[
+				{"id":1,"identifier":"old","hostname":"web-1","dcIdentifier":"dc-1"},
+				{"id":3,"identifier":"new","hostname":"web-1","dcIdentifier":"dc-1"}]}`)
+		case r.URL.Path == "/api/v2/vm/new":
+			fmt.Fprint(w, `{"error":false,"data":{"vmData":{"id":3,"identifier":"new","state":"running"}}}`)
+		case r.URL.Path == "/api/v2/vm/status/new":
+			fmt.Fprint(w, `{"error":false,"data":{"status":"running"}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+	creation, err := client.Server.CreateServer(ctx, &CreateServerRequest{Hostname: "web-1", DcIdentifier: "dc-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := listed.Load(); got != 0 {
+		t.Errorf("CreateServer listed the servers %d times, want 0", got)
+	}
+
+	vm, err := creation.Wait(ctx, fastWait)
+	if err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	if vm.Identifier != "new" {
+		t.Errorf("resolved %q, want new", vm.Identifier)
+	}
+	if got := pendingPolls.Load(); got != resolvePolls+1 {
+		t.Errorf("polled the pending queue %d times, want %d", got, resolvePolls+1)
+	}
+}
+
+func TestServerCreationWaitWithoutService(t *testing.T) {
+	creation := &ServerCreation{Identifier: "vm-1"}
+	if _, err := creation.Wait(context.Background(), fastWait); err == nil {
+		t.Fatal("expected an error for a ServerCreation not returned by CreateServer")
+	}
+}
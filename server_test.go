@@ -0,0 +1,62 @@
+package govpsie
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestGetServerDetails(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/vm/vm-1" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"error":false,"data":{
+			"vmData":{"identifier":"vm-1","hostname":"web-1"},
+			"imageCategories":[{"id":3,"name":"Ubuntu","category":"linux"}],
+			"vmTags":[{"id":1,"name":"prod"},{"id":2,"name":"web"}],
+			"privateIpData":[{"id":7,"ip":"10.0.0.5","ip_version":"ipv4","vpc_id":12,"vpcName":"backend"}],
+			"floatingIpData":[{"id":9,"ip":"203.0.113.7","ip_version":"ipv4","dcIdentifier":"dc-1"}]}}`)
+	})
+
+	details, err := client.Server.GetServerDetails(context.Background(), "vm-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if details.VmData.Hostname != "web-1" {
+		t.Errorf("Hostname = %q", details.VmData.Hostname)
+	}
+	if got := details.TagNames(); !reflect.DeepEqual(got, []string{"prod", "web"}) {
+		t.Errorf("TagNames = %v", got)
+	}
+	if len(details.ImageCategories) != 1 || details.ImageCategories[0].Name != "Ubuntu" {
+		t.Errorf("ImageCategories = %+v", details.ImageCategories)
+	}
+	if len(details.PrivateIpData) != 1 || details.PrivateIpData[0].IP != "10.0.0.5" || details.PrivateIpData[0].VpcID != 12 {
+		t.Errorf("PrivateIpData = %+v", details.PrivateIpData)
+	}
+	if len(details.FloatingIpData) != 1 || details.FloatingIpData[0].IP != "203.0.113.7" {
+		t.Errorf("FloatingIpData = %+v", details.FloatingIpData)
+	}
+}
+
+func TestGetServerByIdentifierIgnoresDetails(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// Details of a shape GetServerDetails does not expect.
+		fmt.Fprint(w, `{"error":false,"data":{
+			"vmData":{"identifier":"vm-1","hostname":"web-1"},
+			"imageCategories":{"id":"3"},
+			"privateIpData":[{"id":"7","vpc_id":"12"}]}}`)
+	})
+
+	vm, err := client.Server.GetServerByIdentifier(context.Background(), "vm-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vm.Hostname != "web-1" {
+		t.Errorf("Hostname = %q", vm.Hostname)
+	}
+}
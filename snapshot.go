@@ -13,6 +13,7 @@ var backupBasePath = "/apps/v2/backup"
 
 type SnapshotService interface {
 	List(ctx context.Context, options *ListOptions) ([]Snapshot, error)
+	ListWithResponse(ctx context.Context, options *ListOptions) ([]Snapshot, *Response, error)
 	Create(ctx context.Context, name, vmIdentifier, note string) error
 	ListByVm(ctx context.Context, options *ListOptions, vmIdentifier string) ([]Snapshot, error)
 	Rollback(ctx context.Context, snapshotIdentifier string) error
@@ -85,24 +86,37 @@ type EnableAutoSnapshotReq struct {
 }
 
 func (s *snapshotServiceHandler) List(ctx context.Context, options *ListOptions) ([]Snapshot, error) {
-	path := fmt.Sprintf("%s?offset=%d&limit%d", snapshotBasePath, options.Page, options.PerPage)
+	items, _, err := s.ListWithResponse(ctx, options)
+	return items, err
+}
+
+func (s *snapshotServiceHandler) ListWithResponse(ctx context.Context, options *ListOptions) ([]Snapshot, *Response, error) {
+	ctx = withOperation(ctx, "SnapshotService", "List")
+
+	path, err := addOptions(snapshotBasePath, options)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	snapshots := new(ListSnapshotsRoot)
 
-	if err = s.client.Do(ctx, req, &snapshots); err != nil {
-		return nil, err
+	resp, err := s.client.DoWithResponse(ctx, req, &snapshots)
+	if err != nil {
+		return nil, resp, err
 	}
 
-	return snapshots.Data, nil
+	return snapshots.Data, resp, nil
 
 }
 
 func (s *snapshotServiceHandler) Create(ctx context.Context, name, vmIdentifier, note string) error {
+	ctx = withOperation(ctx, "SnapshotService", "Create")
+
 	path := fmt.Sprintf("%s/add", snapshotBasePath)
 	createSnapshotReq := struct {
 		Name         string `json:"name"`
@@ -124,6 +138,8 @@ func (s *snapshotServiceHandler) Create(ctx context.Context, name, vmIdentifier,
 }
 
 func (s *snapshotServiceHandler) Get(ctx context.Context, buckupIdentifier string) (*Snapshot, error) {
+	ctx = withOperation(ctx, "SnapshotService", "Get")
+
 	path := fmt.Sprintf("%s/%s", backupBasePath, buckupIdentifier)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -140,6 +156,8 @@ func (s *snapshotServiceHandler) Get(ctx context.Context, buckupIdentifier strin
 }
 
 func (s *snapshotServiceHandler) Update(ctx context.Context, snapshotIdentifier, newNote string) error {
+	ctx = withOperation(ctx, "SnapshotService", "Update")
+
 	path := "/api/v2/backups/update"
 
 	updateReq := struct {
@@ -159,7 +177,12 @@ func (s *snapshotServiceHandler) Update(ctx context.Context, snapshotIdentifier,
 }
 
 func (s *snapshotServiceHandler) ListByVm(ctx context.Context, options *ListOptions, vmIdentifier string) ([]Snapshot, error) {
-	path := fmt.Sprintf("/apps/v2/vm/snapshot/%s?offset=%d&limit%d", vmIdentifier, options.Page, options.PerPage)
+	ctx = withOperation(ctx, "SnapshotService", "ListByVm")
+
+	path, err := addOptions(fmt.Sprintf("/apps/v2/vm/snapshot/%s", vmIdentifier), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -177,6 +200,8 @@ func (s *snapshotServiceHandler) ListByVm(ctx context.Context, options *ListOpti
 }
 
 func (s *snapshotServiceHandler) Delete(ctx context.Context, snapshotIdentifier, reason, note string) error {
+	ctx = withOperation(ctx, "SnapshotService", "Delete")
+
 	deleteReq := struct {
 		SnapshotIdentifier string `json:"snapshotIdentifier"`
 		DeleteStatistic    struct {
@@ -203,6 +228,8 @@ func (s *snapshotServiceHandler) Delete(ctx context.Context, snapshotIdentifier,
 }
 
 func (s *snapshotServiceHandler) Rollback(ctx context.Context, snapshotIdentifier string) error {
+	ctx = withOperation(ctx, "SnapshotService", "Rollback")
+
 	path := fmt.Sprintf("%s/rollback", snapshotBasePath)
 
 	rollbackReq := struct {
@@ -220,6 +247,8 @@ func (s *snapshotServiceHandler) Rollback(ctx context.Context, snapshotIdentifie
 }
 
 func (s *snapshotServiceHandler) EnableAuto(ctx context.Context, enableReq *EnableAutoSnapshotReq) error {
+	ctx = withOperation(ctx, "SnapshotService", "EnableAuto")
+
 	path := fmt.Sprintf("%s/enable/auto", snapshotBasePath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, enableReq)
@@ -285,7 +314,12 @@ type CreateSnapShotPolicyReq struct {
 }
 
 func (s *snapshotServiceHandler) ListSnapShotPolicies(ctx context.Context, options *ListOptions) ([]SnapShotPolicyListDetail, error) {
-	path := fmt.Sprintf("%s/policy/all", snapshotBasePath)
+	ctx = withOperation(ctx, "SnapshotService", "ListSnapShotPolicies")
+
+	path, err := addOptions(fmt.Sprintf("%s/policy/all", snapshotBasePath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -301,6 +335,8 @@ func (s *snapshotServiceHandler) ListSnapShotPolicies(ctx context.Context, optio
 }
 
 func (s *snapshotServiceHandler) GetSnapShotPolicy(ctx context.Context, identifier string) (*SnapShotPolicy, error) {
+	ctx = withOperation(ctx, "SnapshotService", "GetSnapShotPolicy")
+
 	path := fmt.Sprintf("%s/policy/%s", snapshotBasePath, identifier)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -317,6 +353,8 @@ func (s *snapshotServiceHandler) GetSnapShotPolicy(ctx context.Context, identifi
 }
 
 func (s *snapshotServiceHandler) CreateSnapShotPolicy(ctx context.Context, createReq *CreateSnapShotPolicyReq) error {
+	ctx = withOperation(ctx, "SnapshotService", "CreateSnapShotPolicy")
+
 	path := fmt.Sprintf("%s/policy/create", snapshotBasePath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, createReq)
@@ -328,6 +366,8 @@ func (s *snapshotServiceHandler) CreateSnapShotPolicy(ctx context.Context, creat
 }
 
 func (s *snapshotServiceHandler) DeleteSnapShotPolicy(ctx context.Context, policyId, identifier string) error {
+	ctx = withOperation(ctx, "SnapshotService", "DeleteSnapShotPolicy")
+
 	path := fmt.Sprintf("%s/policy/%s", snapshotBasePath, identifier)
 
 	deleteSnapShot := struct {
@@ -345,6 +385,8 @@ func (s *snapshotServiceHandler) DeleteSnapShotPolicy(ctx context.Context, polic
 }
 
 func (s *snapshotServiceHandler) ManageRetainSnapShotPolicy(ctx context.Context, policyId string, keep int64) error {
+	ctx = withOperation(ctx, "SnapshotService", "ManageRetainSnapShotPolicy")
+
 	path := fmt.Sprintf("%s/policy/keep", snapshotBasePath)
 
 	manageSnapShot := struct {
@@ -364,6 +406,8 @@ func (s *snapshotServiceHandler) ManageRetainSnapShotPolicy(ctx context.Context,
 }
 
 func (s *snapshotServiceHandler) AttachSnapShotPolicy(ctx context.Context, policyId string, vms []string) error {
+	ctx = withOperation(ctx, "SnapshotService", "AttachSnapShotPolicy")
+
 	path := fmt.Sprintf("%s/policy/attach", snapshotsBasePath)
 
 	attachSnapShot := struct {
@@ -383,6 +427,8 @@ func (s *snapshotServiceHandler) AttachSnapShotPolicy(ctx context.Context, polic
 }
 
 func (s *snapshotServiceHandler) DetachSnapShotPolicy(ctx context.Context, policyId string, vms []string) error {
+	ctx = withOperation(ctx, "SnapshotService", "DetachSnapShotPolicy")
+
 	path := fmt.Sprintf("%s/policy/detach", snapshotsBasePath)
 
 	detachSnapShot := struct {
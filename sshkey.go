@@ -44,6 +44,8 @@ type SShKey struct {
 var _ SshkeysService = &sshkeysServiceHandler{}
 
 func (s *sshkeysServiceHandler) List(ctx context.Context) ([]SShKey, error) {
+	ctx = withOperation(ctx, "SshkeysService", "List")
+
 	req, err := s.client.NewRequest(ctx, http.MethodGet, sshkeysBasePath, nil)
 	if err != nil {
 		return nil, err
@@ -57,6 +59,8 @@ func (s *sshkeysServiceHandler) List(ctx context.Context) ([]SShKey, error) {
 	return sshKeys.Data, nil
 }
 func (s *sshkeysServiceHandler) Delete(ctx context.Context, sshKeyIdentifier string) error {
+	ctx = withOperation(ctx, "SshkeysService", "Delete")
+
 	delReq := struct {
 		Identifier string `json:"identifier"`
 	}{
@@ -75,6 +79,8 @@ func (s *sshkeysServiceHandler) Delete(ctx context.Context, sshKeyIdentifier str
 	return nil
 }
 func (s *sshkeysServiceHandler) Get(ctx context.Context, sshKeyIdentifier string) (*SShKey, error) {
+	ctx = withOperation(ctx, "SshkeysService", "Get")
+
 	path := fmt.Sprintf("%s/%s", sshkeyBasePath, sshKeyIdentifier)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -91,6 +97,8 @@ func (s *sshkeysServiceHandler) Get(ctx context.Context, sshKeyIdentifier string
 }
 
 func (s *sshkeysServiceHandler) Create(ctx context.Context, privateKey, name string) error {
+	ctx = withOperation(ctx, "SshkeysService", "Create")
+
 	path := fmt.Sprintf("%s/add", sshkeyBasePath)
 
 	createReq := struct {
@@ -0,0 +1,222 @@
+package govpsie
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/vpsieinc/govpsie/stats"
+)
+
+// Metric is a server statistic.
+type Metric string
+
+const (
+	// MetricCPU is the CPU usage, as a fraction of the allocated CPUs.
+	MetricCPU Metric = "cpu"
+	// MetricRAM is the memory used, in bytes.
+	MetricRAM Metric = "mem"
+	// MetricDiskRead and MetricDiskWrite are disk throughputs, in bytes/s.
+	MetricDiskRead  Metric = "diskread"
+	MetricDiskWrite Metric = "diskwrite"
+	// MetricNetIn and MetricNetOut are network throughputs, in bytes/s.
+	MetricNetIn  Metric = "netin"
+	MetricNetOut Metric = "netout"
+)
+
+// Metrics lists every metric.
+var Metrics = []Metric{MetricCPU, MetricRAM, MetricDiskRead, MetricDiskWrite, MetricNetIn, MetricNetOut}
+
+type StatisticsService interface {
+	GetServerStatistics(ctx context.Context, vmIdentifier string, req *StatisticsRequest) (*ServerStatistics, error)
+	GetTrafficUsage(ctx context.Context, vmIdentifier string, from, to time.Time) (*TrafficUsage, error)
+}
+
+type statisticsServiceHandler struct {
+	client *Client
+}
+
+var _ StatisticsService = &statisticsServiceHandler{}
+
+// StatisticsRequest selects the statistics returned by GetServerStatistics.
+type StatisticsRequest struct {
+	// From and To bound the time range, the last 24 hours by default.
+	From time.Time
+	To   time.Time
+
+	// Resolution is the interval between points. Finer points returned by
+	// the API are averaged down to it. Zero keeps the API resolution.
+	Resolution time.Duration
+
+	// Metrics selects the series returned, all of them by default.
+	Metrics []Metric
+}
+
+// DataPoint is one value of a series. The stats package summarizes and
+// downsamples series.
+type DataPoint = stats.Point
+
+// ServerStatistics holds the time series of a server, keyed by metric.
+type ServerStatistics struct {
+	Identifier string
+	From       time.Time
+	To         time.Time
+	Series     map[Metric][]DataPoint
+}
+
+// TrafficUsage is the network traffic of a server over a period, against
+// the traffic included in its plan.
+type TrafficUsage struct {
+	From time.Time
+	To   time.Time
+
+	// InBytes and OutBytes are the bytes received and sent.
+	InBytes  float64
+	OutBytes float64
+
+	// LimitBytes is the traffic included in the plan, from VmData.Traffic
+	// in GB, zero if unlimited or unknown.
+	LimitBytes float64
+}
+
+// UsedBytes is the traffic consumed, in and out.
+func (u *TrafficUsage) UsedBytes() float64 {
+	return u.InBytes + u.OutBytes
+}
+
+// Percent is the share of the included traffic consumed, zero without a
+// limit.
+func (u *TrafficUsage) Percent() float64 {
+	if u.LimitBytes <= 0 {
+		return 0
+	}
+
+	return u.UsedBytes() / u.LimitBytes * 100
+}
+
+type ServerStatisticsRoot struct {
+	Error bool                   `json:"error"`
+	Data  []map[string]flexFloat `json:"data"`
+}
+
+// flexFloat decodes numbers the API may send as JSON numbers or strings.
+type flexFloat float64
+
+func (f *flexFloat) UnmarshalJSON(data []byte) error {
+	data = bytes.Trim(data, `"`)
+	if len(data) == 0 || string(data) == "null" {
+		*f = flexFloat(math.NaN())
+		return nil
+	}
+
+	v, err := strconv.ParseFloat(string(data), 64)
+	if err != nil {
+		return fmt.Errorf("vpsie: invalid statistic %q", data)
+	}
+	*f = flexFloat(v)
+
+	return nil
+}
+
+// GetServerStatistics returns the time series of a server.
+func (s *statisticsServiceHandler) GetServerStatistics(ctx context.Context, vmIdentifier string, sr *StatisticsRequest) (*ServerStatistics, error) {
+	ctx = withOperation(ctx, "StatisticsService", "GetServerStatistics")
+
+	var r StatisticsRequest
+	if sr != nil {
+		r = *sr
+	}
+	if r.To.IsZero() {
+		r.To = time.Now()
+	}
+	if r.From.IsZero() {
+		r.From = r.To.Add(-24 * time.Hour)
+	}
+	if len(r.Metrics) == 0 {
+		r.Metrics = Metrics
+	}
+
+	query := url.Values{
+		"from": {strconv.FormatInt(r.From.Unix(), 10)},
+		"to":   {strconv.FormatInt(r.To.Unix(), 10)},
+	}
+	if r.Resolution > 0 {
+		query.Set("resolution", strconv.FormatInt(int64(r.Resolution/time.Second), 10))
+	}
+	path := fmt.Sprintf("%s/statistics/%s?%s", serverBasePath, vmIdentifier, query.Encode())
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	root := new(ServerStatisticsRoot)
+	if err = s.client.Do(ctx, req, root); err != nil {
+		return nil, err
+	}
+
+	statistics := &ServerStatistics{
+		Identifier: vmIdentifier,
+		From:       r.From,
+		To:         r.To,
+		Series:     make(map[Metric][]DataPoint, len(r.Metrics)),
+	}
+
+	sort.SliceStable(root.Data, func(i, j int) bool { return root.Data[i]["time"] < root.Data[j]["time"] })
+	for _, row := range root.Data {
+		ts, ok := row["time"]
+		if !ok || math.IsNaN(float64(ts)) {
+			continue
+		}
+		t := time.Unix(int64(ts), 0)
+		if t.Before(r.From) || t.After(r.To) {
+			continue
+		}
+
+		for _, m := range r.Metrics {
+			if v, ok := row[string(m)]; ok && !math.IsNaN(float64(v)) {
+				statistics.Series[m] = append(statistics.Series[m], DataPoint{Time: t, Value: float64(v)})
+			}
+		}
+	}
+
+	if r.Resolution > 0 {
+		for m, points := range statistics.Series {
+			statistics.Series[m] = stats.Downsample(points, r.Resolution, stats.AggregateMean)
+		}
+	}
+
+	return statistics, nil
+}
+
+// GetTrafficUsage returns the traffic of a server between from and to,
+// integrated from its network throughput.
+func (s *statisticsServiceHandler) GetTrafficUsage(ctx context.Context, vmIdentifier string, from, to time.Time) (*TrafficUsage, error) {
+	vm, err := s.client.Server.GetServerByIdentifier(ctx, vmIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	statistics, err := s.GetServerStatistics(ctx, vmIdentifier, &StatisticsRequest{
+		From:    from,
+		To:      to,
+		Metrics: []Metric{MetricNetIn, MetricNetOut},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TrafficUsage{
+		From:       statistics.From,
+		To:         statistics.To,
+		InBytes:    stats.Integrate(statistics.Series[MetricNetIn]),
+		OutBytes:   stats.Integrate(statistics.Series[MetricNetOut]),
+		LimitBytes: float64(vm.Traffic) * 1e9,
+	}, nil
+}
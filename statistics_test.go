@@ -0,0 +1,57 @@
+package govpsie
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestGetServerStatistics(t *testing.T) {
+	var query string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/vm/statistics/vm-1" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		query = r.URL.RawQuery
+		_, _ = w.Write([]byte(`{"error":false,"data":[
+			{"time":1060,"cpu":"0.5","netin":100},
+			{"time":1000,"cpu":0.1,"mem":2048,"netin":300},
+			{"time":1120,"cpu":0.3,"netin":null},
+			{"time":5000,"cpu":0.9}
+		]}`))
+	})
+
+	from, to := time.Unix(1000, 0), time.Unix(1200, 0)
+	stats, err := client.Statistics.GetServerStatistics(context.Background(), "vm-1", &StatisticsRequest{
+		From:       from,
+		To:         to,
+		Resolution: 2 * time.Minute,
+		Metrics:    []Metric{MetricCPU, MetricNetIn},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if query != "from=1000&resolution=120&to=1200" {
+		t.Errorf("query = %s", query)
+	}
+
+	cpu := stats.Series[MetricCPU]
+	if len(cpu) != 2 || cpu[0].Time.Unix() != 960 || math.Abs(cpu[0].Value-0.3) > 1e-9 || cpu[1].Value != 0.3 {
+		t.Errorf("cpu = %+v", cpu)
+	}
+	if netin := stats.Series[MetricNetIn]; len(netin) != 1 || netin[0].Value != 200 {
+		t.Errorf("netin = %+v", netin)
+	}
+	if _, ok := stats.Series[MetricRAM]; ok {
+		t.Error("unrequested metric returned")
+	}
+}
+
+func TestTrafficUsagePercent(t *testing.T) {
+	usage := TrafficUsage{InBytes: 4e9, OutBytes: 1e9, LimitBytes: 10e9}
+	if usage.Percent() != 50 {
+		t.Errorf("Percent = %v", usage.Percent())
+	}
+}
@@ -0,0 +1,161 @@
+// Package stats downsamples and summarizes time series such as the ones
+// returned by govpsie.StatisticsService.
+package stats
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// Point is one value of a series.
+type Point struct {
+	Time  time.Time
+	Value float64
+}
+
+// Aggregation reduces the values of a bucket to one.
+type Aggregation func(values []float64) float64
+
+// Aggregations for Downsample.
+var (
+	AggregateMean Aggregation = func(values []float64) float64 { return Sum(values) / float64(len(values)) }
+	AggregateSum  Aggregation = Sum
+	AggregateMax  Aggregation = func(values []float64) float64 { return extremum(values, math.Max) }
+	AggregateMin  Aggregation = func(values []float64) float64 { return extremum(values, math.Min) }
+	AggregateLast Aggregation = func(values []float64) float64 { return values[len(values)-1] }
+)
+
+// Sum adds values.
+func Sum(values []float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+
+	return sum
+}
+
+func extremum(values []float64, pick func(a, b float64) float64) float64 {
+	v := values[0]
+	for _, x := range values[1:] {
+		v = pick(v, x)
+	}
+
+	return v
+}
+
+// Downsample groups time ordered points into buckets of step, aligned on
+// multiples of step, and aggregates each bucket into a point at its start.
+func Downsample(points []Point, step time.Duration, agg Aggregation) []Point {
+	if step <= 0 || len(points) == 0 {
+		return points
+	}
+
+	var (
+		out    []Point
+		start  time.Time
+		values []float64
+	)
+	flush := func() {
+		if len(values) > 0 {
+			out = append(out, Point{Time: start, Value: agg(values)})
+		}
+	}
+
+	for _, p := range points {
+		bucket := p.Time.Truncate(step)
+		if len(values) == 0 || !bucket.Equal(start) {
+			flush()
+			start, values = bucket, values[:0]
+		}
+		values = append(values, p.Value)
+	}
+	flush()
+
+	return out
+}
+
+// LTTB reduces time ordered points to at most threshold points with the
+// largest triangle three buckets algorithm, which keeps the visual shape of
+// a graph.
+func LTTB(points []Point, threshold int) []Point {
+	if threshold >= len(points) || threshold < 3 {
+		return points
+	}
+
+	x := func(p Point) float64 { return float64(p.Time.UnixNano()) }
+
+	out := make([]Point, 0, threshold)
+	out = append(out, points[0])
+
+	every := float64(len(points)-2) / float64(threshold-2)
+	a := 0
+	for i := 0; i < threshold-2; i++ {
+		// Average of the next bucket.
+		next := int(float64(i+1)*every) + 1
+		nextEnd := min(int(float64(i+2)*every)+1, len(points))
+		var avgX, avgY float64
+		for _, p := range points[next:nextEnd] {
+			avgX += x(p)
+			avgY += p.Value
+		}
+		n := float64(nextEnd - next)
+		avgX, avgY = avgX/n, avgY/n
+
+		// Point of the current bucket forming the largest triangle.
+		start, end := int(float64(i)*every)+1, next
+		best, bestArea := start, -1.0
+		for j := start; j < end; j++ {
+			area := math.Abs((x(points[a])-avgX)*(points[j].Value-points[a].Value) -
+				(x(points[a])-x(points[j]))*(avgY-points[a].Value))
+			if area > bestArea {
+				best, bestArea = j, area
+			}
+		}
+
+		out = append(out, points[best])
+		a = best
+	}
+
+	return append(out, points[len(points)-1])
+}
+
+// Integrate returns the area under a rate series, e.g. the bytes
+// transferred from a bytes/s series, each value holding until the next
+// point.
+func Integrate(points []Point) float64 {
+	var total float64
+	for i := 1; i < len(points); i++ {
+		total += points[i-1].Value * points[i].Time.Sub(points[i-1].Time).Seconds()
+	}
+
+	return total
+}
+
+// Summary summarizes a series for capacity reports.
+type Summary struct {
+	Min, Max, Mean, P95 float64
+	Count               int
+}
+
+// Summarize returns the summary of points.
+func Summarize(points []Point) Summary {
+	if len(points) == 0 {
+		return Summary{}
+	}
+
+	values := make([]float64, len(points))
+	for i, p := range points {
+		values[i] = p.Value
+	}
+	sort.Float64s(values)
+
+	return Summary{
+		Min:   values[0],
+		Max:   values[len(values)-1],
+		Mean:  Sum(values) / float64(len(values)),
+		P95:   values[int(math.Ceil(0.95*float64(len(values))))-1],
+		Count: len(values),
+	}
+}
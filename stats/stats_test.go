@@ -0,0 +1,68 @@
+package stats
+
+import (
+	"testing"
+	"time"
+)
+
+func points(values ...float64) []Point {
+	out := make([]Point, len(values))
+	for i, v := range values {
+		out[i] = Point{Time: time.Unix(int64(i*60), 0), Value: v}
+	}
+	return out
+}
+
+func TestDownsample(t *testing.T) {
+	in := points(1, 5, 3, 7, 2)
+
+	got := Downsample(in, 2*time.Minute, AggregateMax)
+	want := []float64{5, 7, 2}
+	if len(got) != len(want) {
+		t.Fatalf("got %+v", got)
+	}
+	for i := range want {
+		if got[i].Value != want[i] || got[i].Time.Unix() != int64(i*120) {
+			t.Errorf("point %d = %+v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if got := Downsample(in, 5*time.Minute, AggregateSum); len(got) != 1 || got[0].Value != 18 {
+		t.Errorf("sum = %+v", got)
+	}
+}
+
+func TestLTTBKeepsEndsAndPeaks(t *testing.T) {
+	in := points(0, 1, 0, 0, 9, 0, 0, 1, 0, 0)
+
+	got := LTTB(in, 4)
+	if len(got) != 4 {
+		t.Fatalf("len = %d", len(got))
+	}
+	if got[0] != in[0] || got[3] != in[len(in)-1] {
+		t.Errorf("ends not kept: %+v", got)
+	}
+	var peak bool
+	for _, p := range got {
+		peak = peak || p.Value == 9
+	}
+	if !peak {
+		t.Errorf("peak dropped: %+v", got)
+	}
+	if got := LTTB(in, 20); len(got) != len(in) {
+		t.Errorf("threshold above length changed the series")
+	}
+}
+
+func TestIntegrateAndSummarize(t *testing.T) {
+	in := points(10, 20, 30)
+
+	if got := Integrate(in); got != 1800 {
+		t.Errorf("Integrate = %v, want 1800", got)
+	}
+
+	s := Summarize(in)
+	if s.Min != 10 || s.Max != 30 || s.Mean != 20 || s.P95 != 30 || s.Count != 3 {
+		t.Errorf("Summarize = %+v", s)
+	}
+}
@@ -11,6 +11,7 @@ var storageBasePath = "/apps/v2"
 
 type StorageService interface {
 	List(ctx context.Context, options *ListOptions) ([]Storage, error)
+	ListWithResponse(ctx context.Context, options *ListOptions) ([]Storage, *Response, error)
 	Delete(ctx context.Context, storageIdentifier string) error
 	AttachToServer(ctx context.Context, storageIdentifier, vmIdentifier string, vmType string) error
 	DetachToServer(ctx context.Context, storageIdentifier, vmIdentifier string, vmType string) error
@@ -25,6 +26,7 @@ type StorageService interface {
 	UpdateName(ctx context.Context, storageIdentifier, name string) error
 	CreateSnapshot(ctx context.Context, storageIdentifier, name, storageType string) error
 	ListSnapshots(ctx context.Context, options *ListOptions) ([]StorageSnapShot, error)
+	ListSnapshotsWithResponse(ctx context.Context, options *ListOptions) ([]StorageSnapShot, *Response, error)
 	UpdateSnapshotName(ctx context.Context, snapshotIdentifier, name string) error
 	RollbackSnapshot(ctx context.Context, snapshotIdentifier, snapType string) error
 	CloneSnapshot(ctx context.Context, snapshotIdentifier, snapType string) error
@@ -178,22 +180,35 @@ type ListStorageDataCenterRoot struct {
 }
 
 func (s *storageServiceHandler) List(ctx context.Context, options *ListOptions) ([]Storage, error) {
-	path := fmt.Sprintf("%s/storages?offset=%d&limit%d", storageBasePath, options.Page, options.PerPage)
+	items, _, err := s.ListWithResponse(ctx, options)
+	return items, err
+}
+
+func (s *storageServiceHandler) ListWithResponse(ctx context.Context, options *ListOptions) ([]Storage, *Response, error) {
+	ctx = withOperation(ctx, "StorageService", "List")
+
+	path, err := addOptions(fmt.Sprintf("%s/storages", storageBasePath), options)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	storages := new(ListStorageRoot)
-	if err = s.client.Do(ctx, req, &storages); err != nil {
-		return nil, err
+	resp, err := s.client.DoWithResponse(ctx, req, &storages)
+	if err != nil {
+		return nil, resp, err
 	}
 
-	return storages.Data, nil
+	return storages.Data, resp, nil
 }
 
 func (s *storageServiceHandler) Delete(ctx context.Context, storageIdentifier string) error {
+	ctx = withOperation(ctx, "StorageService", "Delete")
+
 	path := fmt.Sprintf("%s/storages", storageBasePath)
 
 	deleteRequest := struct {
@@ -211,6 +226,7 @@ func (s *storageServiceHandler) Delete(ctx context.Context, storageIdentifier st
 }
 
 func (s *storageServiceHandler) AttachToServer(ctx context.Context, storageIdentifier, vmIdentifier string, vmType string) error {
+	ctx = withOperation(ctx, "StorageService", "AttachToServer")
 
 	path := fmt.Sprintf("%s/storages/vm/attach", storageBasePath)
 
@@ -234,6 +250,7 @@ func (s *storageServiceHandler) AttachToServer(ctx context.Context, storageIdent
 }
 
 func (s *storageServiceHandler) DetachToServer(ctx context.Context, storageIdentifier, vmIdentifier string, vmType string) error {
+	ctx = withOperation(ctx, "StorageService", "DetachToServer")
 
 	path := fmt.Sprintf("%s/storages/vm/detach", storageBasePath)
 
@@ -256,6 +273,7 @@ func (s *storageServiceHandler) DetachToServer(ctx context.Context, storageIdent
 
 }
 func (s *storageServiceHandler) CreateContainer(ctx context.Context, dcIdentifier string) error {
+	ctx = withOperation(ctx, "StorageService", "CreateContainer")
 
 	path := fmt.Sprintf("%s/storages/create/container", storageBasePath)
 
@@ -274,6 +292,8 @@ func (s *storageServiceHandler) CreateContainer(ctx context.Context, dcIdentifie
 }
 
 func (s *storageServiceHandler) UpdateSize(ctx context.Context, storageIdentifier, size string) error {
+	ctx = withOperation(ctx, "StorageService", "UpdateSize")
+
 	path := fmt.Sprintf("%s/storages/edit", storageBasePath)
 
 	updateReq := struct {
@@ -293,6 +313,8 @@ func (s *storageServiceHandler) UpdateSize(ctx context.Context, storageIdentifie
 }
 
 func (s *storageServiceHandler) UpdateName(ctx context.Context, storageIdentifier, name string) error {
+	ctx = withOperation(ctx, "StorageService", "UpdateName")
+
 	path := fmt.Sprintf("%s/storages/rename", storageBasePath)
 
 	renameReq := struct {
@@ -311,7 +333,12 @@ func (s *storageServiceHandler) UpdateName(ctx context.Context, storageIdentifie
 }
 
 func (s *storageServiceHandler) ListAll(ctx context.Context, options *ListOptions) ([]Storage, error) {
-	path := fmt.Sprintf("%s/storages", storageBasePath)
+	ctx = withOperation(ctx, "StorageService", "ListAll")
+
+	path, err := addOptions(fmt.Sprintf("%s/storages", storageBasePath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -326,6 +353,8 @@ func (s *storageServiceHandler) ListAll(ctx context.Context, options *ListOption
 }
 
 func (s *storageServiceHandler) Create(ctx context.Context, createReq *StorageCreateRequest, vmIdentifier string, vmType string) error {
+	ctx = withOperation(ctx, "StorageService", "Create")
+
 	path := fmt.Sprintf("%s/storages/vm/attach/all", storageBasePath)
 
 	createPayload := struct {
@@ -349,6 +378,8 @@ func (s *storageServiceHandler) Create(ctx context.Context, createReq *StorageCr
 }
 
 func (s *storageServiceHandler) Get(ctx context.Context, identifier string) (*StorageDetail, error) {
+	ctx = withOperation(ctx, "StorageService", "Get")
+
 	path := fmt.Sprintf("%s/storages/%s", storageBasePath, identifier)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -365,6 +396,8 @@ func (s *storageServiceHandler) Get(ctx context.Context, identifier string) (*St
 }
 
 func (s *storageServiceHandler) ListVmsToAttach(ctx context.Context) ([]VmToAttach, error) {
+	ctx = withOperation(ctx, "StorageService", "ListVmsToAttach")
+
 	path := fmt.Sprintf("%s/storages/vms", storageBasePath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -383,6 +416,8 @@ func (s *storageServiceHandler) ListVmsToAttach(ctx context.Context) ([]VmToAtta
 }
 
 func (s *storageServiceHandler) CreateStorage(ctx context.Context, createReq *StorageCreateRequest) error {
+	ctx = withOperation(ctx, "StorageService", "CreateStorage")
+
 	path := fmt.Sprintf("%s/storages/create/multiple", storageBasePath)
 	fullReq := struct {
 		Storages []StorageCreateRequest `json:"storages"`
@@ -399,6 +434,8 @@ func (s *storageServiceHandler) CreateStorage(ctx context.Context, createReq *St
 }
 
 func (s *storageServiceHandler) CreateVolume(ctx context.Context, creatReq *StorageCreateRequest) error {
+	ctx = withOperation(ctx, "StorageService", "CreateVolume")
+
 	path := fmt.Sprintf("%s/storage/create", storageBasePath)
 	fullReq := struct {
 		Storages []StorageCreateRequest `json:"storages"`
@@ -417,6 +454,8 @@ func (s *storageServiceHandler) CreateVolume(ctx context.Context, creatReq *Stor
 }
 
 func (s *storageServiceHandler) DetachAllFromServer(ctx context.Context, vmIdentifier string, vmType string) error {
+	ctx = withOperation(ctx, "StorageService", "DetachAllFromServer")
+
 	path := fmt.Sprintf("%s/storages/vm/detach/all", storageBasePath)
 
 	detachReq := struct {
@@ -437,6 +476,8 @@ func (s *storageServiceHandler) DetachAllFromServer(ctx context.Context, vmIdent
 }
 
 func (s *storageServiceHandler) CreateSnapshot(ctx context.Context, storageIdentifier, name, storageType string) error {
+	ctx = withOperation(ctx, "StorageService", "CreateSnapshot")
+
 	path := fmt.Sprintf("%s/storages/snapshot", storageBasePath)
 
 	snapshotReq := struct {
@@ -458,22 +499,35 @@ func (s *storageServiceHandler) CreateSnapshot(ctx context.Context, storageIdent
 }
 
 func (s *storageServiceHandler) ListSnapshots(ctx context.Context, options *ListOptions) ([]StorageSnapShot, error) {
-	path := fmt.Sprintf("%s/storage/snapshots?offset=%d&limit=%d", storageBasePath, options.Page, options.PerPage)
+	items, _, err := s.ListSnapshotsWithResponse(ctx, options)
+	return items, err
+}
+
+func (s *storageServiceHandler) ListSnapshotsWithResponse(ctx context.Context, options *ListOptions) ([]StorageSnapShot, *Response, error) {
+	ctx = withOperation(ctx, "StorageService", "ListSnapshots")
+
+	path, err := addOptions(fmt.Sprintf("%s/storage/snapshots", storageBasePath), options)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	snapshots := new(ListStorageSnapShotRoot)
-	if err = s.client.Do(ctx, req, &snapshots); err != nil {
-		return nil, err
+	resp, err := s.client.DoWithResponse(ctx, req, &snapshots)
+	if err != nil {
+		return nil, resp, err
 	}
 
-	return snapshots.Data, nil
+	return snapshots.Data, resp, nil
 }
 
 func (s *storageServiceHandler) UpdateSnapshotName(ctx context.Context, snapshotIdentifier, name string) error {
+	ctx = withOperation(ctx, "StorageService", "UpdateSnapshotName")
+
 	path := fmt.Sprintf("%s/storages/snapshot/rename", storageBasePath)
 
 	renameReq := struct {
@@ -493,6 +547,8 @@ func (s *storageServiceHandler) UpdateSnapshotName(ctx context.Context, snapshot
 }
 
 func (s *storageServiceHandler) RollbackSnapshot(ctx context.Context, snapshotIdentifier, snapType string) error {
+	ctx = withOperation(ctx, "StorageService", "RollbackSnapshot")
+
 	path := fmt.Sprintf("%s/storages/snapshot/rollback", storageBasePath)
 
 	rollbackReq := struct {
@@ -512,6 +568,8 @@ func (s *storageServiceHandler) RollbackSnapshot(ctx context.Context, snapshotId
 }
 
 func (s *storageServiceHandler) CloneSnapshot(ctx context.Context, snapshotIdentifier, snapType string) error {
+	ctx = withOperation(ctx, "StorageService", "CloneSnapshot")
+
 	path := fmt.Sprintf("%s/storages/snapshot/clone", storageBasePath)
 
 	cloneReq := struct {
@@ -531,6 +589,8 @@ func (s *storageServiceHandler) CloneSnapshot(ctx context.Context, snapshotIdent
 }
 
 func (s *storageServiceHandler) DeleteSnapshot(ctx context.Context, snapshotIdentifier string) error {
+	ctx = withOperation(ctx, "StorageService", "DeleteSnapshot")
+
 	path := fmt.Sprintf("%s/storages/snapshot/delete", storageBasePath)
 
 	deleteReq := struct {
@@ -548,6 +608,8 @@ func (s *storageServiceHandler) DeleteSnapshot(ctx context.Context, snapshotIden
 }
 
 func (s *storageServiceHandler) DeleteAllSnapshots(ctx context.Context, storageIdentifier string) error {
+	ctx = withOperation(ctx, "StorageService", "DeleteAllSnapshots")
+
 	path := fmt.Sprintf("%s/storages/snapshots/delete/all", storageBasePath)
 
 	deleteReq := struct {
@@ -565,6 +627,8 @@ func (s *storageServiceHandler) DeleteAllSnapshots(ctx context.Context, storageI
 }
 
 func (s *storageServiceHandler) ListStorageDataCenter(ctx context.Context) ([]DataCenter, error) {
+	ctx = withOperation(ctx, "StorageService", "ListStorageDataCenter")
+
 	path := fmt.Sprintf("%s/storage/datacenter", storageBasePath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -0,0 +1,232 @@
+package govpsie
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// TagService manages the tags of servers and finds resources by tag.
+type TagService interface {
+	List(ctx context.Context) ([]string, error)
+	GetServerTags(ctx context.Context, vmIdentifier string) ([]string, error)
+	SetServerTags(ctx context.Context, vmIdentifier string, tags []string) error
+	AddServerTags(ctx context.Context, vmIdentifier string, tags ...string) error
+	RemoveServerTags(ctx context.Context, vmIdentifier string, tags ...string) error
+	ListResourcesByTag(ctx context.Context, tag string) ([]TaggedResource, error)
+}
+
+type tagServiceHandler struct {
+	client *Client
+}
+
+var _ TagService = &tagServiceHandler{}
+
+// Resource types of TaggedResource.
+const (
+	ResourceServer  = "server"
+	ResourceBucket  = "bucket"
+	ResourceDomain  = "domain"
+	ResourceGateway = "gateway"
+	ResourceScript  = "script"
+)
+
+// TaggedResource is a resource found by ListResourcesByTag.
+type TaggedResource struct {
+	Type       string
+	Identifier string
+	Name       string
+	Tags       TagList
+}
+
+// TagList is a list of tag names. The resources echo the tags field of their
+// create requests (CreateServerRequest, CreateBucketReq, CreateDomainRequest,
+// CreateGatewayReq, CreateScriptRequest), whose response shape is not
+// documented, so it decodes arrays of names, arrays of tag objects and comma
+// separated strings alike.
+type TagList []string
+
+func (t *TagList) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*t = nil
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*t = nil
+		for _, tag := range strings.Split(s, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				*t = append(*t, tag)
+			}
+		}
+		return nil
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+
+	tags := make(TagList, 0, len(items))
+	for _, item := range items {
+		var name string
+		if err := json.Unmarshal(item, &name); err == nil {
+			tags = append(tags, name)
+			continue
+		}
+
+		var obj struct {
+			Name    string `json:"name"`
+			Tag     string `json:"tag"`
+			TagName string `json:"tagName"`
+		}
+		if err := json.Unmarshal(item, &obj); err != nil {
+			return err
+		}
+		for _, name := range []string{obj.Name, obj.Tag, obj.TagName} {
+			if name != "" {
+				tags = append(tags, name)
+				break
+			}
+		}
+	}
+	*t = tags
+
+	return nil
+}
+
+// Has reports whether the list contains tag, ignoring case.
+func (t TagList) Has(tag string) bool {
+	for _, name := range t {
+		if strings.EqualFold(name, tag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// List lists the tags in use in the account, ignoring case. The API has no
+// endpoint listing tags, so they are collected from the resources that
+// ListResourcesByTag searches.
+func (t *tagServiceHandler) List(ctx context.Context) ([]string, error) {
+	var tags TagList
+	err := t.eachResource(ctx, func(r TaggedResource) {
+		for _, name := range r.Tags {
+			if !tags.Has(name) {
+				tags = append(tags, name)
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(tags)
+
+	return tags, nil
+}
+
+// GetServerTags returns the tags of a server.
+func (t *tagServiceHandler) GetServerTags(ctx context.Context, vmIdentifier string) ([]string, error) {
+	details, err := t.client.Server.GetServerDetails(ctx, vmIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return details.TagNames(), nil
+}
+
+// SetServerTags replaces the tags of a server.
+func (t *tagServiceHandler) SetServerTags(ctx context.Context, vmIdentifier string, tags []string) error {
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return t.client.Server.EditTag(ctx, tags, vmIdentifier)
+}
+
+// AddServerTags adds tags to a server.
+func (t *tagServiceHandler) AddServerTags(ctx context.Context, vmIdentifier string, tags ...string) error {
+	return t.client.Server.AddTags(ctx, vmIdentifier, tags)
+}
+
+// RemoveServerTags removes tags, ignoring case, from a server.
+func (t *tagServiceHandler) RemoveServerTags(ctx context.Context, vmIdentifier string, tags ...string) error {
+	current, err := t.GetServerTags(ctx, vmIdentifier)
+	if err != nil {
+		return err
+	}
+
+	kept := make([]string, 0, len(current))
+	for _, tag := range current {
+		if !TagList(tags).Has(tag) {
+			kept = append(kept, tag)
+		}
+	}
+	if len(kept) == len(current) {
+		return nil
+	}
+
+	return t.SetServerTags(ctx, vmIdentifier, kept)
+}
+
+// ListResourcesByTag returns the servers, buckets, domains, gateways and
+// scripts tagged with tag, ignoring case.
+func (t *tagServiceHandler) ListResourcesByTag(ctx context.Context, tag string) ([]TaggedResource, error) {
+	var found []TaggedResource
+	err := t.eachResource(ctx, func(r TaggedResource) {
+		if r.Tags.Has(tag) {
+			found = append(found, r)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return found, nil
+}
+
+// eachResource calls fn for every server, bucket, domain, gateway and script.
+func (t *tagServiceHandler) eachResource(ctx context.Context, fn func(TaggedResource)) error {
+	for vm, err := range t.client.AllServers(ctx, nil) {
+		if err != nil {
+			return err
+		}
+		fn(TaggedResource{Type: ResourceServer, Identifier: vm.Identifier, Name: vm.Hostname, Tags: vm.Tags})
+	}
+
+	for b, err := range t.client.AllBuckets(ctx, nil) {
+		if err != nil {
+			return err
+		}
+		fn(TaggedResource{Type: ResourceBucket, Identifier: b.Identifier, Name: b.BucketName, Tags: b.Tags})
+	}
+
+	for d, err := range t.client.AllDomains(ctx, nil) {
+		if err != nil {
+			return err
+		}
+		fn(TaggedResource{Type: ResourceDomain, Identifier: d.Identifier, Name: d.DomainName, Tags: d.Tags})
+	}
+
+	for g, err := range t.client.AllGateways(ctx, nil) {
+		if err != nil {
+			return err
+		}
+		fn(TaggedResource{Type: ResourceGateway, Identifier: strconv.FormatInt(g.ID, 10), Name: g.IP, Tags: g.Tags})
+	}
+
+	for s, err := range t.client.AllScripts(ctx, nil) {
+		if err != nil {
+			return err
+		}
+		fn(TaggedResource{Type: ResourceScript, Identifier: s.Identifier, Name: s.ScriptName, Tags: s.Tags})
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package govpsie_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/vpsieinc/govpsie"
+	"github.com/vpsieinc/govpsie/vpsietest"
+)
+
+func TestTagService(t *testing.T) {
+	srv := vpsietest.NewServer()
+	defer srv.Close()
+
+	web := srv.AddServer(govpsie.VmData{Hostname: "web-1", Tags: govpsie.TagList{"prod", "web"}})
+	srv.AddServer(govpsie.VmData{Hostname: "db-1", Tags: govpsie.TagList{"staging"}})
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	if err := client.Bucket.Create(ctx, &govpsie.CreateBucketReq{BucketName: "assets", Tags: []string{"Prod"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	tags, err := client.Tag.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"prod", "staging", "web"}; !reflect.DeepEqual(tags, want) {
+		t.Errorf("List = %v, want %v", tags, want)
+	}
+
+	if err := client.Tag.AddServerTags(ctx, web.Identifier, "billing-42", "prod"); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Tag.RemoveServerTags(ctx, web.Identifier, "WEB"); err != nil {
+		t.Fatal(err)
+	}
+	got, err := client.Tag.GetServerTags(ctx, web.Identifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"prod", "billing-42"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetServerTags = %v, want %v", got, want)
+	}
+
+	resources, err := client.Tag.ListResourcesByTag(ctx, "prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resources) != 2 ||
+		resources[0].Type != govpsie.ResourceServer || resources[0].Name != "web-1" ||
+		resources[1].Type != govpsie.ResourceBucket || resources[1].Name != "assets" {
+		t.Errorf("ListResourcesByTag = %+v", resources)
+	}
+
+	if err := client.Tag.SetServerTags(ctx, web.Identifier, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := client.Tag.GetServerTags(ctx, web.Identifier); len(got) != 0 {
+		t.Errorf("tags after SetServerTags(nil) = %v", got)
+	}
+}
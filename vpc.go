@@ -28,7 +28,7 @@ var _ VPCService = &vpcServiceHandler{}
 type ListVPCRoot struct {
 	Error bool  `json:"error"`
 	Data  []VPC `json:"data"`
-	Total int   `json:"total "`
+	Total int   `json:"total"`
 }
 
 type GetVPCRoot struct {
@@ -83,7 +83,12 @@ type VPC struct {
 }
 
 func (s *vpcServiceHandler) List(ctx context.Context, options *ListOptions) ([]VPC, error) {
-	path := fmt.Sprintf("%s/vpc", vpcPath)
+	ctx = withOperation(ctx, "VPCService", "List")
+
+	path, err := addOptions(fmt.Sprintf("%s/vpc", vpcPath), options)
+	if err != nil {
+		return nil, err
+	}
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -98,6 +103,8 @@ func (s *vpcServiceHandler) List(ctx context.Context, options *ListOptions) ([]V
 }
 
 func (s *vpcServiceHandler) Get(ctx context.Context, id string) (*VPC, error) {
+	ctx = withOperation(ctx, "VPCService", "Get")
+
 	path := fmt.Sprintf("%s/vpc/%s", vpcPath, id)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -113,6 +120,8 @@ func (s *vpcServiceHandler) Get(ctx context.Context, id string) (*VPC, error) {
 }
 
 func (s *vpcServiceHandler) AssignServer(ctx context.Context, assignReq *AssignServerReq) error {
+	ctx = withOperation(ctx, "VPCService", "AssignServer")
+
 	path := fmt.Sprintf("%s/vm/add/vpc", vpcPath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, assignReq)
@@ -124,6 +133,8 @@ func (s *vpcServiceHandler) AssignServer(ctx context.Context, assignReq *AssignS
 }
 
 func (s *vpcServiceHandler) MoveServer(ctx context.Context, assignReq *AssignServerReq) error {
+	ctx = withOperation(ctx, "VPCService", "MoveServer")
+
 	path := fmt.Sprintf("%s/vm/vpc/move", vpcPath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, assignReq)
@@ -135,6 +146,8 @@ func (s *vpcServiceHandler) MoveServer(ctx context.Context, assignReq *AssignSer
 }
 
 func (s *vpcServiceHandler) CreateVpc(ctx context.Context, createReq *CreateVpcReq) error {
+	ctx = withOperation(ctx, "VPCService", "CreateVpc")
+
 	path := fmt.Sprintf("%s/vpc/add", vpcPath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, createReq)
@@ -146,6 +159,8 @@ func (s *vpcServiceHandler) CreateVpc(ctx context.Context, createReq *CreateVpcR
 }
 
 func (s *vpcServiceHandler) ReleasePrivateIP(ctx context.Context, vmIdentifer string, privateIpId int) error {
+	ctx = withOperation(ctx, "VPCService", "ReleasePrivateIP")
+
 	path := fmt.Sprintf("%s/vm/vpc", vpcPath)
 
 	realseReq := struct {
@@ -165,6 +180,8 @@ func (s *vpcServiceHandler) ReleasePrivateIP(ctx context.Context, vmIdentifer st
 }
 
 func (s *vpcServiceHandler) DeleteVpc(ctx context.Context, vpcId, reason, note string) error {
+	ctx = withOperation(ctx, "VPCService", "DeleteVpc")
+
 	path := fmt.Sprintf("%s/vpc/%s", vpcPath, vpcId)
 
 	deleteReq := struct {
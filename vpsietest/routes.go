@@ -0,0 +1,1027 @@
+package vpsietest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vpsieinc/govpsie"
+)
+
+// deleteStatistic is sent along with most delete requests.
+type deleteStatistic struct {
+	Reason string `json:"reason"`
+	Note   string `json:"note"`
+}
+
+func (s *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST /apps/v2/auth/from/api", s.login)
+
+	// Servers.
+	mux.HandleFunc("GET /api/v2/vm", s.listServers)
+	mux.HandleFunc("POST /api/v2/vm", s.createServer)
+	mux.HandleFunc("DELETE /api/v2/vm", s.deleteServer)
+	mux.HandleFunc("GET /api/v2/vm/{id}", s.getServer)
+	mux.HandleFunc("GET /api/v2/vm/status/{id}", s.getServerStatus)
+	mux.HandleFunc("GET /api/v2/vm/console/{id}", s.getServerConsole)
+	mux.HandleFunc("GET /apps/v2/vm/pending", s.listPendingServers)
+	mux.HandleFunc("GET /api/v2/vm/resources/{dc}", s.listResourcePlans)
+	mux.HandleFunc("GET /apps/v2/images/os/{dc}", s.listOSImages)
+	mux.HandleFunc("POST /api/v2/vm/start", s.serverAction(func(vm *govpsie.VmData) { vm.Power, vm.State = 1, "running" }))
+	mux.HandleFunc("POST /api/v2/vm/stop", s.serverAction(func(vm *govpsie.VmData) { vm.Power, vm.State = 0, "stopped" }))
+	mux.HandleFunc("POST /api/v2/vm/restart", s.serverAction(func(vm *govpsie.VmData) { vm.Power, vm.State = 1, "restarting" }))
+	mux.HandleFunc("POST /api/v2/vm/changehostname", s.changeHostname)
+	mux.HandleFunc("POST /api/v2/vm/addtags", s.editServerTags(false))
+	mux.HandleFunc("POST /api/v2/vm/tags/edit", s.editServerTags(true))
+
+	// Storage.
+	mux.HandleFunc("GET /apps/v2/storages", s.listStorages)
+	mux.HandleFunc("GET /apps/v2/storages/{id}", s.getStorage)
+	mux.HandleFunc("POST /apps/v2/storage/create", s.createStorage)
+	mux.HandleFunc("DELETE /apps/v2/storages", s.deleteStorage)
+	mux.HandleFunc("POST /apps/v2/storages/vm/attach", s.attachStorage(true))
+	mux.HandleFunc("POST /apps/v2/storages/vm/detach", s.attachStorage(false))
+
+	// Snapshots.
+	mux.HandleFunc("GET /apps/v2/snapshot", s.listSnapshots)
+	mux.HandleFunc("POST /apps/v2/snapshot/add", s.createSnapshot)
+	mux.HandleFunc("DELETE /apps/v2/snapshot", s.deleteSnapshot)
+	mux.HandleFunc("GET /apps/v2/vm/snapshot/{vm}", s.listServerSnapshots)
+	mux.HandleFunc("POST /apps/v2/custom/vm", s.createServerFromSnapshot)
+
+	// Backups.
+	mux.HandleFunc("GET /apps/v2/backups", s.listBackups)
+	mux.HandleFunc("POST /apps/v2/backup/add", s.createBackup)
+	mux.HandleFunc("GET /apps/v2/backup/{id}", s.getBackup)
+	mux.HandleFunc("DELETE /apps/v2/backup", s.deleteBackup)
+	mux.HandleFunc("GET /apps/v2/vm/backups/{vm}", s.listServerBackups)
+	mux.HandleFunc("POST /apps/v2/backups/create", s.restoreBackup)
+
+	// Firewall groups.
+	mux.HandleFunc("GET /apps/v2/firewall/groups", s.listFirewallGroups)
+	mux.HandleFunc("GET /apps/v2/firewall/group/{id}", s.getFirewallGroup)
+	mux.HandleFunc("POST /apps/v2/firewall/create/group", s.createFirewallGroup)
+	mux.HandleFunc("DELETE /apps/v2/firewall/delete/group", s.deleteFirewallGroup)
+	mux.HandleFunc("POST /apps/v2/firewall/setGroupVm", s.assignFirewallGroup)
+
+	// Domains.
+	mux.HandleFunc("GET /apps/v2/domains", s.listDomains)
+	mux.HandleFunc("POST /apps/v2/domain/add", s.createDomain)
+	mux.HandleFunc("DELETE /apps/v2/domain/delete", s.deleteDomain)
+
+	// Load balancers.
+	mux.HandleFunc("GET /api/v1/lb/all", s.listLBs)
+	mux.HandleFunc("GET /api/v1/lb/{id}", s.getLB)
+	mux.HandleFunc("POST /api/v1/lb/create", s.createLB)
+	mux.HandleFunc("DELETE /api/v1/lb/{id}", s.deleteLB)
+
+	// VPCs.
+	mux.HandleFunc("GET /apps/v2/vpc", s.listVPCs)
+	mux.HandleFunc("GET /apps/v2/vpc/{id}", s.getVPC)
+	mux.HandleFunc("POST /apps/v2/vpc/add", s.createVPC)
+	mux.HandleFunc("DELETE /apps/v2/vpc/{id}", s.deleteVPC)
+	mux.HandleFunc("POST /apps/v2/vm/add/vpc", s.assignVPC)
+
+	// Buckets.
+	mux.HandleFunc("GET /apps/v2/buckets", s.listBuckets)
+	mux.HandleFunc("POST /apps/v2/bucket", s.getBucket)
+	mux.HandleFunc("POST /apps/v2/bucket/create", s.createBucket)
+	mux.HandleFunc("DELETE /apps/v2/bucket/delete", s.deleteBucket)
+
+	// Gateways and scripts are listed, always empty.
+	mux.HandleFunc("GET /apps/v2/gateways/ips", func(w http.ResponseWriter, r *http.Request) {
+		writeData(w, map[string]interface{}{"rows": []govpsie.Gateway{}, "count": 0})
+	})
+	mux.HandleFunc("GET /apps/v2/scripts", func(w http.ResponseWriter, r *http.Request) {
+		writeList(w, r, []govpsie.Script{})
+	})
+
+	// Projects.
+	mux.HandleFunc("GET /apps/v2/projects", s.listProjects)
+	mux.HandleFunc("GET /apps/v2/projects/{id}", s.getProject)
+	mux.HandleFunc("POST /apps/v2/projects/add", s.createProject)
+	mux.HandleFunc("DELETE /apps/v2/projects/{id}", s.deleteProject)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "vpsietest: no route for "+r.Method+" "+r.URL.Path)
+	})
+
+	return s.authenticate(mux)
+}
+
+// authenticate rejects requests without the expected token, except logins.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/apps/v2/auth/from/api" && r.Header.Get("Vpsie-Auth") != s.Token {
+			writeError(w, http.StatusUnauthorized, "invalid token")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) login(w http.ResponseWriter, r *http.Request) {
+	var req govpsie.LoginReq
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.ClientID != ClientID || req.ClientSecret != ClientSecret {
+		writeError(w, http.StatusUnauthorized, "invalid credentials")
+		return
+	}
+
+	expires := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	writeJSON(w, http.StatusOK, govpsie.TokenRoot{
+		Token: govpsie.Token{
+			Access:  govpsie.TokenDetails{Token: s.Token, Expires: expires},
+			Refresh: govpsie.TokenDetails{Token: newIdentifier(), Expires: expires},
+		},
+	})
+}
+
+// Servers.
+
+func (s *Server) listServers(w http.ResponseWriter, r *http.Request) {
+	servers := s.servers.list()
+	if projectID := r.URL.Query().Get("projectId"); projectID != "" {
+		filtered := servers[:0]
+		for _, vm := range servers {
+			if strconv.FormatInt(vm.ProjectID, 10) == projectID {
+				filtered = append(filtered, vm)
+			}
+		}
+		servers = filtered
+	}
+
+	writeList(w, r, servers)
+}
+
+func (s *Server) createServer(w http.ResponseWriter, r *http.Request) {
+	var req govpsie.CreateServerRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Hostname == "" || req.DcIdentifier == "" {
+		writeError(w, http.StatusBadRequest, "hostname and dcIdentifier are required")
+		return
+	}
+
+	vm := s.addServer(&req)
+	writeData(w, map[string]string{"identifier": vm.Identifier})
+}
+
+// addServer stores the server requested by req.
+func (s *Server) addServer(req *govpsie.CreateServerRequest) *govpsie.VmData {
+	projectID, _ := strconv.ParseInt(req.ProjectID, 10, 64)
+	vm := govpsie.VmData{
+		ID:           s.id(),
+		Identifier:   newIdentifier(),
+		Hostname:     req.Hostname,
+		DcIdentifier: req.DcIdentifier,
+		ProjectID:    projectID,
+		Notes:        req.Notes,
+		Ram:          1024,
+		Cpu:          1,
+		Ssd:          int64(max(req.Ssd, 25)),
+		State:        "running",
+		Power:        1,
+		IsActive:     1,
+		CreatedOn:    now(),
+		LastUpdated:  now(),
+	}
+	for _, tag := range req.Tags {
+		if tag != nil && !vm.Tags.Has(*tag) {
+			vm.Tags = append(vm.Tags, *tag)
+		}
+	}
+	s.servers.add(vm)
+
+	stored, _ := s.servers.get(vm.Identifier)
+	return stored
+}
+
+func (s *Server) getServer(w http.ResponseWriter, r *http.Request) {
+	vm, ok := s.servers.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "server not found")
+		return
+	}
+
+	vmTags := make([]govpsie.VmTags, len(vm.Tags))
+	for i, tag := range vm.Tags {
+		vmTags[i] = govpsie.VmTags{ID: int64(i + 1), Name: tag}
+	}
+
+	writeData(w, govpsie.ServerDetails{
+		VmData:          *vm,
+		ImageCategories: []govpsie.ImageCategories{},
+		VmTags:          vmTags,
+		PrivateIpData:   s.privateIPs(vm.Identifier),
+		FloatingIpData:  []govpsie.FloatingIpData{},
+	})
+}
+
+// getServerConsole hands out a Proxmox style console ticket.
+func (s *Server) getServerConsole(w http.ResponseWriter, r *http.Request) {
+	vm, ok := s.servers.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "server not found")
+		return
+	}
+
+	writeData(w, govpsie.ServerConsole{
+		Upid:     fmt.Sprintf("UPID:vpsietest:00000001:00000001:00000001:vncproxy:%d:root@pam:", vm.ID),
+		Ticket:   "PVEVNC:" + newIdentifier(),
+		User:     "root@pam",
+		Port:     "5900",
+		Fullname: vm.Hostname,
+	})
+}
+
+// listPendingServers reports an empty queue, servers being created at once.
+// listPendingServers reports the pending queue, then provisions the servers
+// in it.
+func (s *Server) listPendingServers(w http.ResponseWriter, r *http.Request) {
+	vms := []govpsie.PendingVm{}
+	for _, p := range s.pending {
+		vms = append(vms, p.vm)
+		p.provision()
+	}
+	s.pending = nil
+
+	writeData(w, vms)
+}
+
+func (s *Server) listResourcePlans(w http.ResponseWriter, r *http.Request) {
+	writeData(w, append([]govpsie.ResourcePlan{}, s.plans[r.PathValue("dc")]...))
+}
+
+func (s *Server) listOSImages(w http.ResponseWriter, r *http.Request) {
+	writeData(w, append([]govpsie.OSImage{}, s.osImages[r.PathValue("dc")]...))
+}
+
+func (s *Server) getServerStatus(w http.ResponseWriter, r *http.Request) {
+	vm, ok := s.servers.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "server not found")
+		return
+	}
+
+	writeData(w, govpsie.Status{Status: vm.State, Fullname: vm.Hostname})
+
+	// A restart is seen once, then the server runs again.
+	if vm.State == "restarting" {
+		vm.State = "running"
+	}
+}
+
+func (s *Server) deleteServer(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		VMIdentifier    string          `json:"vmIdentifier"`
+		DeleteStatistic deleteStatistic `json:"deleteStatistic"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if !s.servers.remove(req.VMIdentifier) {
+		writeError(w, http.StatusNotFound, "server not found")
+		return
+	}
+
+	writeOK(w)
+}
+
+// serverAction applies update to the server named in an ActionRequest.
+func (s *Server) serverAction(update func(vm *govpsie.VmData)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req govpsie.ActionRequest
+		if !decode(w, r, &req) {
+			return
+		}
+		vm, ok := s.servers.get(req.VmIdentifier)
+		if !ok {
+			writeError(w, http.StatusNotFound, "server not found")
+			return
+		}
+
+		update(vm)
+		vm.LastUpdated = now()
+		writeOK(w)
+	}
+}
+
+// editServerTags adds tags to a server, or replaces them.
+func (s *Server) editServerTags(replace bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			VmIdentifier string   `json:"vmIdentifier"`
+			Tags         []string `json:"tags"`
+		}
+		if !decode(w, r, &req) {
+			return
+		}
+		vm, ok := s.servers.get(req.VmIdentifier)
+		if !ok {
+			writeError(w, http.StatusNotFound, "server not found")
+			return
+		}
+
+		if replace {
+			vm.Tags = nil
+		}
+		for _, tag := range req.Tags {
+			if !vm.Tags.Has(tag) {
+				vm.Tags = append(vm.Tags, tag)
+			}
+		}
+		writeOK(w)
+	}
+}
+
+func (s *Server) changeHostname(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		VmIdentifier string `json:"vmIdentifier"`
+		Hostname     string `json:"hostname"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	vm, ok := s.servers.get(req.VmIdentifier)
+	if !ok {
+		writeError(w, http.StatusNotFound, "server not found")
+		return
+	}
+	if req.Hostname == "" || strings.ContainsAny(req.Hostname, " \t_/") {
+		writeError(w, http.StatusBadRequest, "invalid hostname")
+		return
+	}
+
+	vm.Hostname = req.Hostname
+	writeOK(w)
+}
+
+// Storage.
+
+func (s *Server) listStorages(w http.ResponseWriter, r *http.Request) {
+	writeList(w, r, convertAll[govpsie.Storage](s.storages.list()))
+}
+
+func (s *Server) getStorage(w http.ResponseWriter, r *http.Request) {
+	storage, ok := s.storages.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "storage not found")
+		return
+	}
+
+	writeData(w, storage)
+}
+
+func (s *Server) createStorage(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Storages []govpsie.StorageCreateRequest `json:"storages"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	for _, create := range req.Storages {
+		s.storages.add(govpsie.StorageDetail{
+			ID:           int(s.id()),
+			Identifier:   newIdentifier(),
+			Name:         create.Name,
+			DcIdentifier: create.DcIdentifier,
+			Description:  create.Description,
+			Size:         create.Size,
+			StorageType:  create.StorageType,
+			DiskFormat:   create.DiskFormat,
+			CreatedOn:    time.Now().UTC(),
+			UpdatedAt:    time.Now().UTC(),
+		})
+	}
+
+	writeOK(w)
+}
+
+func (s *Server) deleteStorage(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		StorageIdentifier string `json:"storageIdentifier"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if !s.storages.remove(req.StorageIdentifier) {
+		writeError(w, http.StatusNotFound, "storage not found")
+		return
+	}
+
+	writeOK(w)
+}
+
+func (s *Server) attachStorage(attach bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			StorageIdentifier string `json:"storageIdentifier"`
+			VmIdentifier      string `json:"vmIdentifier"`
+		}
+		if !decode(w, r, &req) {
+			return
+		}
+		storage, ok := s.storages.get(req.StorageIdentifier)
+		if !ok {
+			writeError(w, http.StatusNotFound, "storage not found")
+			return
+		}
+		vm, ok := s.servers.get(req.VmIdentifier)
+		if !ok {
+			writeError(w, http.StatusNotFound, "server not found")
+			return
+		}
+
+		if attach {
+			storage.VMIdentifier, storage.Hostname = vm.Identifier, vm.Hostname
+		} else {
+			storage.VMIdentifier, storage.Hostname = "", ""
+		}
+		writeOK(w)
+	}
+}
+
+// Snapshots.
+
+func (s *Server) listSnapshots(w http.ResponseWriter, r *http.Request) {
+	writeList(w, r, s.snapshots.list())
+}
+
+func (s *Server) listServerSnapshots(w http.ResponseWriter, r *http.Request) {
+	var snapshots []govpsie.Snapshot
+	for _, snapshot := range s.snapshots.list() {
+		if snapshot.VmIdentifier == r.PathValue("vm") {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+
+	writeList(w, r, snapshots)
+}
+
+func (s *Server) createSnapshot(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Name         string `json:"name"`
+		VMIdentifier string `json:"vmIdentifier"`
+		Note         string `json:"note"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	vm, ok := s.servers.get(req.VMIdentifier)
+	if !ok {
+		writeError(w, http.StatusNotFound, "server not found")
+		return
+	}
+
+	s.snapshots.add(govpsie.Snapshot{
+		Identifier:   newIdentifier(),
+		Name:         req.Name,
+		Note:         req.Note,
+		VmIdentifier: vm.Identifier,
+		Hostname:     vm.Hostname,
+		DcIdentifier: vm.DcIdentifier,
+		IsSnapshot:   1,
+		State:        "completed",
+		CreatedOn:    time.Now().UTC(),
+	})
+	writeOK(w)
+}
+
+// createServerFromSnapshot creates a server with the specs of the server a
+// snapshot was taken from.
+func (s *Server) createServerFromSnapshot(w http.ResponseWriter, r *http.Request) {
+	var req govpsie.CreateServerRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	snapshot, ok := s.snapshots.get(req.OsIdentifier)
+	if !ok {
+		writeError(w, http.StatusNotFound, "snapshot not found")
+		return
+	}
+	if req.Hostname == "" || req.DcIdentifier == "" {
+		writeError(w, http.StatusBadRequest, "hostname and dcIdentifier are required")
+		return
+	}
+
+	provision := func() {
+		vm := s.addServer(&req)
+		if source, ok := s.servers.get(snapshot.VmIdentifier); ok {
+			vm.Cpu, vm.Ram, vm.Ssd = source.Cpu, source.Ram, source.Ssd
+		}
+	}
+	if req.ProcessID == "" {
+		provision()
+		writeOK(w)
+		return
+	}
+
+	var pending govpsie.PendingVm
+	pending.Data.Hostname, pending.Data.DcIdentifier, pending.Data.ProcessID = req.Hostname, req.DcIdentifier, req.ProcessID
+	s.pending = append(s.pending, pendingServer{vm: pending, provision: provision})
+	writeOK(w)
+}
+
+func (s *Server) deleteSnapshot(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		SnapshotIdentifier string `json:"snapshotIdentifier"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if !s.snapshots.remove(req.SnapshotIdentifier) {
+		writeError(w, http.StatusNotFound, "snapshot not found")
+		return
+	}
+
+	writeOK(w)
+}
+
+// Backups.
+
+func (s *Server) listBackups(w http.ResponseWriter, r *http.Request) {
+	writeList(w, r, s.backups.list())
+}
+
+func (s *Server) listServerBackups(w http.ResponseWriter, r *http.Request) {
+	var backups []govpsie.Backup
+	for _, backup := range s.backups.list() {
+		if backup.VMIdentifier == r.PathValue("vm") {
+			backups = append(backups, backup)
+		}
+	}
+
+	writeList(w, r, backups)
+}
+
+func (s *Server) createBackup(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		VmIdentifier string `json:"vmIdentifier"`
+		Name         string `json:"name"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	vm, ok := s.servers.get(req.VmIdentifier)
+	if !ok {
+		writeError(w, http.StatusNotFound, "server not found")
+		return
+	}
+
+	s.backups.add(govpsie.Backup{
+		Identifier:   newIdentifier(),
+		Name:         req.Name,
+		VMIdentifier: vm.Identifier,
+		HostName:     vm.Hostname,
+		DcIdentifier: vm.DcIdentifier,
+		State:        "completed",
+		CreatedOn:    now(),
+	})
+	writeOK(w)
+}
+
+// restoreBackup creates a copy of the server a backup was taken from, with
+// the same hostname.
+func (s *Server) restoreBackup(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		BackupIdentifier string `json:"backupIdentifier"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	backup, ok := s.backups.get(req.BackupIdentifier)
+	if !ok {
+		writeError(w, http.StatusNotFound, "backup not found")
+		return
+	}
+	source, ok := s.servers.get(backup.VMIdentifier)
+	if !ok {
+		writeError(w, http.StatusNotFound, "server not found")
+		return
+	}
+
+	vm := *source
+	vm.ID, vm.Identifier = s.id(), newIdentifier()
+	vm.Tags = append(govpsie.TagList(nil), source.Tags...)
+	vm.CreatedOn, vm.LastUpdated = now(), now()
+	s.servers.add(vm)
+
+	writeOK(w)
+}
+
+func (s *Server) getBackup(w http.ResponseWriter, r *http.Request) {
+	backup, ok := s.backups.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "backup not found")
+		return
+	}
+
+	writeData(w, map[string]interface{}{"backup": backup})
+}
+
+func (s *Server) deleteBackup(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		BackupIdentifier string `json:"backupIdentifier"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if !s.backups.remove(req.BackupIdentifier) {
+		writeError(w, http.StatusNotFound, "backup not found")
+		return
+	}
+
+	writeOK(w)
+}
+
+// Firewall groups.
+
+func (s *Server) listFirewallGroups(w http.ResponseWriter, r *http.Request) {
+	writeList(w, r, s.firewallGroups.list())
+}
+
+func (s *Server) getFirewallGroup(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.firewallGroups.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "firewall group not found")
+		return
+	}
+
+	writeData(w, govpsie.FirewallGroupDetailData{Group: convert[govpsie.FirewallGroup](group)})
+}
+
+func (s *Server) createFirewallGroup(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		GroupName string                      `json:"groupName"`
+		Rules     []govpsie.FirewallUpdateReq `json:"rules"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.GroupName == "" {
+		writeError(w, http.StatusBadRequest, "groupName is required")
+		return
+	}
+
+	group := govpsie.FirewallGroupListData{
+		ID:         s.id(),
+		Identifier: newIdentifier(),
+		GroupName:  req.GroupName,
+		CreatedOn:  now(),
+		UpdatedOn:  now(),
+	}
+	s.firewallGroups.add(group)
+
+	writeOK(w)
+}
+
+func (s *Server) deleteFirewallGroup(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		GroupID string `json:"groupId"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if !s.firewallGroups.remove(req.GroupID) {
+		writeError(w, http.StatusNotFound, "firewall group not found")
+		return
+	}
+
+	writeOK(w)
+}
+
+func (s *Server) assignFirewallGroup(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		VmID    string `json:"vmId"`
+		GroupID string `json:"groupId"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	group, ok := s.firewallGroups.get(req.GroupID)
+	if !ok {
+		writeError(w, http.StatusNotFound, "firewall group not found")
+		return
+	}
+	vm, ok := s.servers.get(req.VmID)
+	if !ok {
+		writeError(w, http.StatusNotFound, "server not found")
+		return
+	}
+
+	group.VmsData = append(group.VmsData, govpsie.VmsData{Hostname: vm.Hostname, Identifier: vm.Identifier})
+	group.Vms = int64(len(group.VmsData))
+	writeOK(w)
+}
+
+// Domains.
+
+func (s *Server) listDomains(w http.ResponseWriter, r *http.Request) {
+	writeList(w, r, s.domains.list())
+}
+
+func (s *Server) createDomain(w http.ResponseWriter, r *http.Request) {
+	var req govpsie.CreateDomainRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Domain == "" {
+		writeError(w, http.StatusBadRequest, "domain is required")
+		return
+	}
+
+	s.domains.add(govpsie.Domain{
+		Identifier: newIdentifier(),
+		DomainName: req.Domain,
+		CreatedOn:  now(),
+		Tags:       req.Tags,
+	})
+	writeOK(w)
+}
+
+func (s *Server) deleteDomain(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		DomainIdentifier string `json:"domainIdentifier"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if !s.domains.remove(req.DomainIdentifier) {
+		writeError(w, http.StatusNotFound, "domain not found")
+		return
+	}
+
+	writeOK(w)
+}
+
+// Load balancers.
+
+func (s *Server) listLBs(w http.ResponseWriter, r *http.Request) {
+	writeList(w, r, s.lbs.list())
+}
+
+func (s *Server) getLB(w http.ResponseWriter, r *http.Request) {
+	lb, ok := s.lbs.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "load balancer not found")
+		return
+	}
+
+	writeData(w, convert[govpsie.LBDetails](lb))
+}
+
+func (s *Server) createLB(w http.ResponseWriter, r *http.Request) {
+	var req govpsie.CreateLBReq
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.LBName == "" || req.DcIdentifier == "" {
+		writeError(w, http.StatusBadRequest, "lbName and dcIdentifier are required")
+		return
+	}
+
+	s.lbs.add(govpsie.LB{
+		Identifier: newIdentifier(),
+		LBName:     req.LBName,
+		CreatedOn:  now(),
+		UpdatedAt:  now(),
+	})
+	writeOK(w)
+}
+
+func (s *Server) deleteLB(w http.ResponseWriter, r *http.Request) {
+	if !s.lbs.remove(r.PathValue("id")) {
+		writeError(w, http.StatusNotFound, "load balancer not found")
+		return
+	}
+
+	writeOK(w)
+}
+
+// VPCs.
+
+func (s *Server) listVPCs(w http.ResponseWriter, r *http.Request) {
+	writeList(w, r, s.vpcs.list())
+}
+
+func (s *Server) getVPC(w http.ResponseWriter, r *http.Request) {
+	vpc, ok := s.vpcs.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "vpc not found")
+		return
+	}
+
+	writeData(w, vpc)
+}
+
+func (s *Server) createVPC(w http.ResponseWriter, r *http.Request) {
+	var req govpsie.CreateVpcReq
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Name == "" || req.DcIdentifier == "" {
+		writeError(w, http.StatusBadRequest, "name and dcIdentifier are required")
+		return
+	}
+
+	size, _ := strconv.Atoi(req.NetworkSize)
+	s.vpcs.add(govpsie.VPC{
+		ID:           int(s.id()),
+		Name:         req.Name,
+		Description:  req.Description,
+		DcIdentifier: req.DcIdentifier,
+		NetworkRange: req.NetworkRange,
+		NetworkSize:  size,
+		CreatedOn:    time.Now().UTC(),
+		LastUpdated:  time.Now().UTC(),
+	})
+	writeOK(w)
+}
+
+func (s *Server) assignVPC(w http.ResponseWriter, r *http.Request) {
+	var req govpsie.AssignServerReq
+	if !decode(w, r, &req) {
+		return
+	}
+	if _, ok := s.vpcs.get(strconv.Itoa(req.VpcID)); !ok {
+		writeError(w, http.StatusNotFound, "vpc not found")
+		return
+	}
+	if _, ok := s.servers.get(req.VmIdentifier); !ok {
+		writeError(w, http.StatusNotFound, "server not found")
+		return
+	}
+
+	s.serverVPCs[req.VmIdentifier] = append(s.serverVPCs[req.VmIdentifier], req.VpcID)
+	writeOK(w)
+}
+
+// privateIPs returns the private addresses of a server, one per VPC.
+func (s *Server) privateIPs(vmIdentifier string) []govpsie.PrivateIpData {
+	ips := []govpsie.PrivateIpData{}
+	for i, id := range s.serverVPCs[vmIdentifier] {
+		vpc, _ := s.vpcs.get(strconv.Itoa(id))
+		var primary int64
+		if i == 0 {
+			primary = 1
+		}
+		ips = append(ips, govpsie.PrivateIpData{
+			ID:        int64(i + 1),
+			IP:        fmt.Sprintf("10.%d.0.%d", id%256, i+2),
+			IPVersion: "ipv4",
+			IsPrimary: primary,
+			VpcID:     int64(id),
+			VpcName:   vpc.Name,
+		})
+	}
+
+	return ips
+}
+
+func (s *Server) deleteVPC(w http.ResponseWriter, r *http.Request) {
+	if !s.vpcs.remove(r.PathValue("id")) {
+		writeError(w, http.StatusNotFound, "vpc not found")
+		return
+	}
+
+	writeOK(w)
+}
+
+// Buckets.
+
+func (s *Server) listBuckets(w http.ResponseWriter, r *http.Request) {
+	writeList(w, r, s.buckets.list())
+}
+
+func (s *Server) getBucket(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		BucketID string `json:"bucketId"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	bucket, ok := s.buckets.get(req.BucketID)
+	if !ok {
+		writeError(w, http.StatusNotFound, "bucket not found")
+		return
+	}
+
+	writeData(w, bucket)
+}
+
+func (s *Server) createBucket(w http.ResponseWriter, r *http.Request) {
+	var req govpsie.CreateBucketReq
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.BucketName == "" {
+		writeError(w, http.StatusBadRequest, "bucketName is required")
+		return
+	}
+
+	s.buckets.add(govpsie.Bucket{
+		ID:         int(s.id()),
+		Identifier: newIdentifier(),
+		BucketName: req.BucketName,
+		AccessKey:  newIdentifier(),
+		SecretKey:  newIdentifier(),
+		CreatedOn:  now(),
+		Tags:       req.Tags,
+	})
+	writeOK(w)
+}
+
+func (s *Server) deleteBucket(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		BucketID string `json:"bucketId"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if !s.buckets.remove(req.BucketID) {
+		writeError(w, http.StatusNotFound, "bucket not found")
+		return
+	}
+
+	writeOK(w)
+}
+
+// Projects.
+
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request) {
+	projects := s.projects.list()
+	writeData(w, govpsie.Data{Rows: paginate(r, projects), Count: len(projects)})
+}
+
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request) {
+	project, ok := s.projects.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "project not found")
+		return
+	}
+
+	writeData(w, project)
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request) {
+	var req govpsie.CreateProjectRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	s.projects.add(govpsie.Project{
+		ID:          uint64(s.id()),
+		Identifier:  newIdentifier(),
+		Name:        req.Name,
+		Description: req.Description,
+		CreatedOn:   now(),
+	})
+	writeOK(w)
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
+	if !s.projects.remove(r.PathValue("id")) {
+		writeError(w, http.StatusNotFound, "project not found")
+		return
+	}
+
+	writeOK(w)
+}
+
+// convert copies the fields two resource representations share by JSON name,
+// e.g. from StorageDetail to Storage.
+func convert[T any](v interface{}) T {
+	var out T
+	if b, err := json.Marshal(v); err == nil {
+		_ = json.Unmarshal(b, &out)
+	}
+
+	return out
+}
+
+func convertAll[T, S any](items []S) []T {
+	out := make([]T, 0, len(items))
+	for _, item := range items {
+		out = append(out, convert[T](item))
+	}
+
+	return out
+}
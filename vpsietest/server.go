@@ -0,0 +1,302 @@
+// Package vpsietest provides an in-memory fake of the VPSie API for tests.
+//
+// The fake is stateful: servers created through ServerService.CreateServer
+// show up in ServerService.List until they are deleted. It covers the
+// servers, storage, snapshot, backup, firewall group, domain, load balancer,
+// VPC, bucket and project endpoints used by the govpsie services, plus the
+// credentials login. Servers created from a snapshot with a ProcessID sit in
+// the pending queue until it is next listed, as a real server being
+// provisioned would. Resource plans and OS images are served as seeded with
+// AddResourcePlans and AddOSImages.
+//
+//	srv := vpsietest.NewServer()
+//	defer srv.Close()
+//
+//	client, err := srv.NewClient()
+package vpsietest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/vpsieinc/govpsie"
+)
+
+// Credentials accepted by the fake login endpoint.
+const (
+	ClientID     = "vpsietest-client"
+	ClientSecret = "vpsietest-secret"
+)
+
+// Server is a fake VPSie API listening on a local address.
+type Server struct {
+	// URL is the base URL of the fake, to be passed to govpsie.WithBaseURL.
+	URL string
+
+	// Token is the access token the fake accepts in the Vpsie-Auth header.
+	Token string
+
+	srv *httptest.Server
+
+	mu             sync.Mutex
+	nextID         int64
+	servers        *store[govpsie.VmData]
+	storages       *store[govpsie.StorageDetail]
+	snapshots      *store[govpsie.Snapshot]
+	backups        *store[govpsie.Backup]
+	firewallGroups *store[govpsie.FirewallGroupListData]
+	domains        *store[govpsie.Domain]
+	lbs            *store[govpsie.LB]
+	vpcs           *store[govpsie.VPC]
+	buckets        *store[govpsie.Bucket]
+	projects       *store[govpsie.Project]
+	plans          map[string][]govpsie.ResourcePlan
+	serverVPCs     map[string][]int
+	osImages       map[string][]govpsie.OSImage
+	pending        []pendingServer
+}
+
+// pendingServer is a server in the pending queue, provisioned by provision.
+type pendingServer struct {
+	vm        govpsie.PendingVm
+	provision func()
+}
+
+// NewServer starts a fake API. Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		Token:          "vpsietest-" + newIdentifier(),
+		servers:        newStore(func(v *govpsie.VmData) string { return v.Identifier }),
+		storages:       newStore(func(v *govpsie.StorageDetail) string { return v.Identifier }),
+		snapshots:      newStore(func(v *govpsie.Snapshot) string { return v.Identifier }),
+		backups:        newStore(func(v *govpsie.Backup) string { return v.Identifier }),
+		firewallGroups: newStore(func(v *govpsie.FirewallGroupListData) string { return v.Identifier }),
+		domains:        newStore(func(v *govpsie.Domain) string { return v.Identifier }),
+		lbs:            newStore(func(v *govpsie.LB) string { return v.Identifier }),
+		vpcs:           newStore(func(v *govpsie.VPC) string { return strconv.Itoa(v.ID) }),
+		buckets:        newStore(func(v *govpsie.Bucket) string { return v.Identifier }),
+		projects:       newStore(func(v *govpsie.Project) string { return v.Identifier }),
+		plans:          make(map[string][]govpsie.ResourcePlan),
+		serverVPCs:     make(map[string][]int),
+		osImages:       make(map[string][]govpsie.OSImage),
+	}
+
+	s.srv = httptest.NewServer(s.routes())
+	s.URL = s.srv.URL + "/apps/v2"
+
+	return s
+}
+
+// Close shuts the fake down.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// HTTPClient returns an HTTP client configured to talk to the fake.
+func (s *Server) HTTPClient() *http.Client {
+	return s.srv.Client()
+}
+
+// NewClient returns a govpsie client talking to the fake with its token.
+// Retries are disabled; opts may override any setting.
+func (s *Server) NewClient(opts ...govpsie.Option) (*govpsie.Client, error) {
+	return govpsie.New(append([]govpsie.Option{
+		govpsie.WithBaseURL(s.URL),
+		govpsie.WithHTTPClient(s.HTTPClient()),
+		govpsie.WithToken(s.Token),
+		govpsie.WithRetryPolicy(nil),
+	}, opts...)...)
+}
+
+// Servers returns the servers currently known to the fake.
+func (s *Server) Servers() []govpsie.VmData {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.servers.list()
+}
+
+// AddServer seeds a server. An empty identifier is generated.
+func (s *Server) AddServer(vm govpsie.VmData) govpsie.VmData {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if vm.Identifier == "" {
+		vm.Identifier = newIdentifier()
+	}
+	if vm.ID == 0 {
+		vm.ID = s.id()
+	}
+	s.servers.add(vm)
+
+	return vm
+}
+
+// Projects returns the projects currently known to the fake.
+func (s *Server) Projects() []govpsie.Project {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.projects.list()
+}
+
+// AddProject seeds a project. An empty identifier is generated.
+func (s *Server) AddProject(project govpsie.Project) govpsie.Project {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if project.Identifier == "" {
+		project.Identifier = newIdentifier()
+	}
+	if project.ID == 0 {
+		project.ID = uint64(s.id())
+	}
+	s.projects.add(project)
+
+	return project
+}
+
+// AddResourcePlans seeds the plans offered in a data center. Plans without
+// an identifier get one generated.
+func (s *Server) AddResourcePlans(dcIdentifier string, plans ...govpsie.ResourcePlan) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, plan := range plans {
+		if plan.Identifier == "" {
+			plan.Identifier = newIdentifier()
+		}
+		s.plans[dcIdentifier] = append(s.plans[dcIdentifier], plan)
+	}
+}
+
+// AddOSImages seeds the stock images offered in a data center. Images
+// without an identifier get one generated.
+func (s *Server) AddOSImages(dcIdentifier string, images ...govpsie.OSImage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, image := range images {
+		if image.Identifier == "" {
+			image.Identifier = newIdentifier()
+		}
+		image.DcIdentifier = dcIdentifier
+		s.osImages[dcIdentifier] = append(s.osImages[dcIdentifier], image)
+	}
+}
+
+// id returns the next numeric id. s.mu must be held.
+func (s *Server) id() int64 {
+	s.nextID++
+	return s.nextID
+}
+
+// store keeps resources in creation order.
+type store[T any] struct {
+	items      []T
+	identifier func(*T) string
+}
+
+func newStore[T any](identifier func(*T) string) *store[T] {
+	return &store[T]{identifier: identifier}
+}
+
+func (s *store[T]) list() []T {
+	return append([]T(nil), s.items...)
+}
+
+func (s *store[T]) add(item T) {
+	s.items = append(s.items, item)
+}
+
+func (s *store[T]) get(id string) (*T, bool) {
+	for i := range s.items {
+		if s.identifier(&s.items[i]) == id {
+			return &s.items[i], true
+		}
+	}
+
+	return nil, false
+}
+
+func (s *store[T]) remove(id string) bool {
+	for i := range s.items {
+		if s.identifier(&s.items[i]) == id {
+			s.items = append(s.items[:i], s.items[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// newIdentifier returns a random UUID shaped identifier.
+func newIdentifier() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	h := hex.EncodeToString(b)
+
+	return h[:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:]
+}
+
+func now() string {
+	return time.Now().UTC().Format("2006-01-02 15:04:05")
+}
+
+// writeJSON writes v with the given status.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an ErrorRsp body with the given status.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, govpsie.ErrorRsp{Error: true, Code: status, Message: message})
+}
+
+// writeData writes {"error":false,"data":data}.
+func writeData(w http.ResponseWriter, data interface{}) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{"error": false, "data": data})
+}
+
+// writeOK acknowledges an action.
+func writeOK(w http.ResponseWriter) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{"error": false, "message": "success"})
+}
+
+// writeList writes one page of items, honoring the offset and limit query
+// parameters, as {"error":false,"data":[...],"total":n}.
+func writeList[T any](w http.ResponseWriter, r *http.Request, items []T) {
+	page := paginate(r, items)
+	writeJSON(w, http.StatusOK, map[string]interface{}{"error": false, "data": page, "total": len(items)})
+}
+
+func paginate[T any](r *http.Request, items []T) []T {
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = len(items)
+	}
+
+	offset = min(max(offset, 0), len(items))
+	end := min(offset+limit, len(items))
+
+	return append([]T{}, items[offset:end]...)
+}
+
+// decode reads the JSON request body into v, answering 400 on failure.
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return false
+	}
+
+	return true
+}
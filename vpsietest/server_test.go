@@ -0,0 +1,149 @@
+package vpsietest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vpsieinc/govpsie"
+	"github.com/vpsieinc/govpsie/vpsietest"
+)
+
+func TestServerLifecycle(t *testing.T) {
+	srv := vpsietest.NewServer()
+	defer srv.Close()
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	creation, err := client.Server.CreateServer(ctx, &govpsie.CreateServerRequest{
+		Hostname:           "web-1",
+		DcIdentifier:       "dc-1",
+		OsIdentifier:       "os-1",
+		ResourceIdentifier: "plan-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	servers, err := client.Server.List(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(servers) != 1 || servers[0].Hostname != "web-1" {
+		t.Fatalf("got servers %+v, want web-1", servers)
+	}
+	if creation.Identifier != servers[0].Identifier {
+		t.Errorf("creation identifier = %q, want %q", creation.Identifier, servers[0].Identifier)
+	}
+
+	created, err := creation.Wait(ctx, &govpsie.WaitOptions{MinInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	if created.State != "running" {
+		t.Errorf("got state %q after Wait, want running", created.State)
+	}
+
+	id := servers[0].Identifier
+	if err := client.Server.StopServer(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	vm, err := client.Server.GetServerByIdentifier(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vm.State != "stopped" {
+		t.Errorf("got state %q after stop, want stopped", vm.State)
+	}
+
+	if err := client.Server.DeleteServer(ctx, id, "", "test", ""); err != nil {
+		t.Fatal(err)
+	}
+	if len(srv.Servers()) != 0 {
+		t.Errorf("server still present after delete")
+	}
+
+	_, err = client.Server.GetServerByIdentifier(ctx, id)
+	if !errors.Is(err, govpsie.ErrNotFound) {
+		t.Errorf("got %v for a deleted server, want ErrNotFound", err)
+	}
+}
+
+func TestListPagination(t *testing.T) {
+	srv := vpsietest.NewServer()
+	defer srv.Close()
+
+	for range 5 {
+		srv.AddServer(govpsie.VmData{Hostname: "vm"})
+	}
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	page, resp, err := client.Server.ListWithResponse(context.Background(), &govpsie.ListOptions{Page: 2, PerPage: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page) != 2 || resp.Total != 5 || resp.NextPage != 3 {
+		t.Errorf("got %d servers, total %d, next page %d", len(page), resp.Total, resp.NextPage)
+	}
+
+	count := 0
+	for _, err := range client.AllServers(context.Background(), &govpsie.ListOptions{PerPage: 2}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		count++
+	}
+	if count != 5 {
+		t.Errorf("iterated over %d servers, want 5", count)
+	}
+}
+
+func TestCredentialsLogin(t *testing.T) {
+	srv := vpsietest.NewServer()
+	defer srv.Close()
+
+	client, err := srv.NewClient(govpsie.WithCredentials(vpsietest.ClientID, vpsietest.ClientSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Project.List(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResourcePlans(t *testing.T) {
+	srv := vpsietest.NewServer()
+	defer srv.Close()
+	srv.AddResourcePlans("dc-1",
+		govpsie.ResourcePlan{Identifier: "plan-2", CPU: 2, Price: 10},
+		govpsie.ResourcePlan{Identifier: "plan-1", CPU: 1, Price: 5},
+	)
+
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plans, err := client.Server.ListResourcePlans(context.Background(), "dc-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := govpsie.SelectResourcePlan(plans, govpsie.PlanRequirements{MinCPU: 2})
+	if err != nil || plan.Identifier != "plan-2" {
+		t.Fatalf("got plan %+v, %v, want plan-2", plan, err)
+	}
+
+	if plans, _ := client.Server.ListResourcePlans(context.Background(), "dc-2"); len(plans) != 0 {
+		t.Errorf("got %d plans for dc-2, want 0", len(plans))
+	}
+}
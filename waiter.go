@@ -0,0 +1,229 @@
+package govpsie
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultWaitTimeout     = 10 * time.Minute
+	defaultWaitMinInterval = 2 * time.Second
+	defaultWaitMaxInterval = 30 * time.Second
+	defaultWaitMultiplier  = 1.5
+)
+
+// ServerState is the state of a server as reported by the API.
+type ServerState string
+
+const (
+	ServerRunning ServerState = "running"
+	ServerStopped ServerState = "stopped"
+
+	// ServerPending is reported while a new server is in the pending queue.
+	ServerPending ServerState = "pending"
+)
+
+// WaitOptions controls how long-running server operations are awaited. The
+// zero value, like a nil *WaitOptions, uses the defaults.
+type WaitOptions struct {
+	// Timeout bounds the whole wait, 10 minutes by default. The context
+	// deadline applies as well.
+	Timeout time.Duration
+
+	// MinInterval and MaxInterval bound the delay between polls, which grows
+	// by Multiplier after each poll. Defaults to 2s, 30s and 1.5.
+	MinInterval time.Duration
+	MaxInterval time.Duration
+	Multiplier  float64
+
+	// Progress, if set, is called after every poll.
+	Progress func(WaitProgress)
+}
+
+// WaitProgress reports one poll of a server being awaited.
+type WaitProgress struct {
+	Identifier string
+	Attempt    int
+	Elapsed    time.Duration
+
+	// State is the state observed, empty if the poll failed.
+	State ServerState
+
+	// Server is the server as returned by the poll, nil if it failed.
+	Server *VmData
+
+	// Err is the error of the poll, if any. Servers not found yet are
+	// polled again.
+	Err error
+}
+
+func (o *WaitOptions) withDefaults() WaitOptions {
+	var opts WaitOptions
+	if o != nil {
+		opts = *o
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultWaitTimeout
+	}
+	if opts.MinInterval <= 0 {
+		opts.MinInterval = defaultWaitMinInterval
+	}
+	if opts.MaxInterval < opts.MinInterval {
+		opts.MaxInterval = max(defaultWaitMaxInterval, opts.MinInterval)
+	}
+	if opts.Multiplier < 1 {
+		opts.Multiplier = defaultWaitMultiplier
+	}
+
+	return opts
+}
+
+// serverState derives the state of a server. The live status of the
+// hypervisor wins over the stored State, which lags behind actions, and
+// Power is used when neither is known.
+func serverState(vm *VmData, status *Status) ServerState {
+	if status != nil && status.Status != "" {
+		return ServerState(strings.ToLower(status.Status))
+	}
+	if vm.State != "" {
+		return ServerState(strings.ToLower(vm.State))
+	}
+	if vm.Power == 1 {
+		return ServerRunning
+	}
+
+	return ServerStopped
+}
+
+// WaitForServerState polls the server until it reaches state and returns it.
+func (v *serverServiceHandler) WaitForServerState(ctx context.Context, identifierId string, state ServerState, opts *WaitOptions) (*VmData, error) {
+	want := ServerState(strings.ToLower(string(state)))
+
+	return v.waitForServer(ctx, identifierId, opts, func(vm *VmData, status *Status, got ServerState) bool {
+		return got == want
+	}, fmt.Sprintf("state %q", state))
+}
+
+// waitForServer polls the server until done reports true, backing off
+// between polls. The status passed to done is nil if it could not be read.
+func (v *serverServiceHandler) waitForServer(ctx context.Context, identifierId string, opts *WaitOptions, done func(*VmData, *Status, ServerState) bool, what string) (*VmData, error) {
+	o := opts.withDefaults()
+
+	ctx, cancel := context.WithTimeout(ctx, o.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	interval := o.MinInterval
+	var last WaitProgress
+
+	for attempt := 1; ; attempt++ {
+		vm, err := v.GetServerByIdentifier(ctx, identifierId)
+		last = WaitProgress{Identifier: identifierId, Attempt: attempt, Server: vm, Err: err}
+		var status *Status
+		if err == nil {
+			// The status endpoint fails for servers still being built, in
+			// which case the stored state is used.
+			status, _ = v.GetServerStatusByIdentifier(ctx, identifierId)
+			last.State = serverState(vm, status)
+		}
+		last.Elapsed = time.Since(start)
+
+		if o.Progress != nil {
+			o.Progress(last)
+		}
+
+		switch {
+		case err == nil && done(vm, status, last.State):
+			return vm, nil
+		case err != nil && !errors.Is(err, ErrNotFound) && ctx.Err() == nil:
+			return nil, err
+		}
+
+		if err := sleep(ctx, interval); err != nil {
+			return nil, fmt.Errorf("vpsie: server %s did not reach %s after %s (last state %q): %w",
+				identifierId, what, time.Since(start).Round(time.Second), last.State, err)
+		}
+		interval = min(time.Duration(float64(interval)*o.Multiplier), o.MaxInterval)
+	}
+}
+
+// CreateServerAndWait creates a server and waits until it is running.
+func (v *serverServiceHandler) CreateServerAndWait(ctx context.Context, server *CreateServerRequest, opts *WaitOptions) (*VmData, error) {
+	creation, err := v.CreateServer(ctx, server)
+	if err != nil {
+		return nil, err
+	}
+
+	return creation.Wait(ctx, opts)
+}
+
+// StartServerAndWait starts a server and waits until it is running.
+func (v *serverServiceHandler) StartServerAndWait(ctx context.Context, identifierId string, opts *WaitOptions) (*VmData, error) {
+	if err := v.StartServer(ctx, identifierId); err != nil {
+		return nil, err
+	}
+
+	return v.WaitForServerState(ctx, identifierId, ServerRunning, opts)
+}
+
+// StopServerAndWait stops a server and waits until it is stopped.
+func (v *serverServiceHandler) StopServerAndWait(ctx context.Context, identifierId string, opts *WaitOptions) (*VmData, error) {
+	if err := v.StopServer(ctx, identifierId); err != nil {
+		return nil, err
+	}
+
+	return v.WaitForServerState(ctx, identifierId, ServerStopped, opts)
+}
+
+// RestartServerAndWait restarts a server and waits until it is running
+// again. The restart is taken as done once the server was seen in another
+// state than running, or reports a lower uptime than before the restart.
+func (v *serverServiceHandler) RestartServerAndWait(ctx context.Context, identifierId string, opts *WaitOptions) (*VmData, error) {
+	var uptime int64
+	if before, err := v.GetServerStatusByIdentifier(ctx, identifierId); err == nil {
+		uptime = before.Uptime
+	}
+
+	if err := v.RestartServer(ctx, identifierId); err != nil {
+		return nil, err
+	}
+
+	var wentDown bool
+	return v.waitForServer(ctx, identifierId, opts, func(vm *VmData, status *Status, state ServerState) bool {
+		if state != ServerRunning {
+			wentDown = true
+			return false
+		}
+		return wentDown || uptime > 0 && status != nil && status.Uptime < uptime
+	}, "restart")
+}
+
+// ResizeServerAndWait resizes a server and waits until it is running with
+// the new cpu and ram.
+func (v *serverServiceHandler) ResizeServerAndWait(ctx context.Context, identifierId, cpu, ram string, opts *WaitOptions) (*VmData, error) {
+	if err := v.ResizeServer(ctx, identifierId, cpu, ram); err != nil {
+		return nil, err
+	}
+
+	wantCpu, _ := strconv.ParseInt(cpu, 10, 64)
+	wantRam, _ := strconv.ParseInt(ram, 10, 64)
+
+	return v.waitForServer(ctx, identifierId, opts, func(vm *VmData, status *Status, state ServerState) bool {
+		return state == ServerRunning &&
+			(wantCpu == 0 || vm.Cpu == wantCpu) &&
+			(wantRam == 0 || vm.Ram == wantRam)
+	}, fmt.Sprintf("%s cpu and %s ram", cpu, ram))
+}
+
+// ResumeAndWait resumes a server and waits until it is running.
+func (v *serverServiceHandler) ResumeAndWait(ctx context.Context, resumeReq *ResumeReq, opts *WaitOptions) (*VmData, error) {
+	if err := v.Resume(ctx, resumeReq); err != nil {
+		return nil, err
+	}
+
+	return v.WaitForServerState(ctx, resumeReq.VmIdentifier, ServerRunning, opts)
+}
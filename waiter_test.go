@@ -0,0 +1,119 @@
+package govpsie
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var fastWait = &WaitOptions{MinInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+
+func TestStartServerAndWait(t *testing.T) {
+	var polls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/vm/start":
+			fmt.Fprint(w, `{"error":false}`)
+		case "/api/v2/vm/vm-1":
+			fmt.Fprint(w, `{"error":false,"data":{"vmData":{"identifier":"vm-1","state":"stopped"}}}`)
+		case "/api/v2/vm/status/vm-1":
+			status := "stopped"
+			if polls.Add(1) >= 3 {
+				status = "running"
+			}
+			fmt.Fprintf(w, `{"error":false,"data":{"status":%q}}`, status)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	var progress []WaitProgress
+	opts := *fastWait
+	opts.Progress = func(p WaitProgress) { progress = append(progress, p) }
+
+	vm, err := client.Server.StartServerAndWait(context.Background(), "vm-1", &opts)
+	if err != nil {
+		t.Fatalf("StartServerAndWait: %v", err)
+	}
+	if vm.Identifier != "vm-1" {
+		t.Errorf("Identifier = %q", vm.Identifier)
+	}
+	if len(progress) != 3 || progress[0].State != ServerStopped || progress[2].State != ServerRunning || progress[2].Attempt != 3 {
+		t.Errorf("progress = %+v", progress)
+	}
+}
+
+func TestWaitForServerStateFallsBackToPower(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/vm/status/vm-1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"error":false,"data":{"vmData":{"identifier":"vm-1","power":0}}}`)
+	})
+
+	if _, err := client.Server.WaitForServerState(context.Background(), "vm-1", ServerStopped, fastWait); err != nil {
+		t.Fatalf("WaitForServerState: %v", err)
+	}
+}
+
+func TestWaitForServerStateTimeout(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"error":false,"data":{"vmData":{"identifier":"vm-1","state":"stopped"}}}`)
+	})
+
+	opts := *fastWait
+	opts.Timeout = 20 * time.Millisecond
+
+	_, err := client.Server.WaitForServerState(context.Background(), "vm-1", ServerRunning, &opts)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want DeadlineExceeded", err)
+	}
+}
+
+func TestWaitForServerStateStopsOnError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, err := client.Server.WaitForServerState(context.Background(), "vm-1", ServerRunning, fastWait)
+	if !errors.Is(err, ErrForbidden) {
+		t.Fatalf("err = %v, want ErrForbidden", err)
+	}
+}
+
+func TestRestartServerAndWaitWaitsForTheRestart(t *testing.T) {
+	var restarted atomic.Bool
+	var polls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/vm/restart":
+			restarted.Store(true)
+			fmt.Fprint(w, `{"error":false}`)
+		case "/api/v2/vm/vm-1":
+			fmt.Fprint(w, `{"error":false,"data":{"vmData":{"identifier":"vm-1","state":"running"}}}`)
+		case "/api/v2/vm/status/vm-1":
+			// Still up on the first poll, rebooted on the second.
+			uptime := 500
+			if restarted.Load() && polls.Add(1) >= 2 {
+				uptime = 3
+			}
+			fmt.Fprintf(w, `{"error":false,"data":{"status":"running","uptime":%d}}`, uptime)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	if _, err := client.Server.RestartServerAndWait(context.Background(), "vm-1", fastWait); err != nil {
+		t.Fatalf("RestartServerAndWait: %v", err)
+	}
+	if got := polls.Load(); got != 2 {
+		t.Errorf("polled %d times, want 2", got)
+	}
+}